@@ -35,6 +35,216 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on EthAuthorizeNonceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeNonceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EthAuthorizeNonceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeNonceRequestMultiError, or nil if none found.
+func (m *EthAuthorizeNonceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EthAuthorizeNonceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return EthAuthorizeNonceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EthAuthorizeNonceRequestMultiError is an error wrapping multiple validation
+// errors returned by EthAuthorizeNonceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EthAuthorizeNonceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EthAuthorizeNonceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EthAuthorizeNonceRequestMultiError) AllErrors() []error { return m }
+
+// EthAuthorizeNonceRequestValidationError is the validation error returned by
+// EthAuthorizeNonceRequest.Validate if the designated constraints aren't met.
+type EthAuthorizeNonceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EthAuthorizeNonceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EthAuthorizeNonceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EthAuthorizeNonceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EthAuthorizeNonceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EthAuthorizeNonceRequestValidationError) ErrorName() string {
+	return "EthAuthorizeNonceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EthAuthorizeNonceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEthAuthorizeNonceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EthAuthorizeNonceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EthAuthorizeNonceRequestValidationError{}
+
+// Validate checks the field values on EthAuthorizeNonceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeNonceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EthAuthorizeNonceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeNonceReplyMultiError, or nil if none found.
+func (m *EthAuthorizeNonceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EthAuthorizeNonceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nonce
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return EthAuthorizeNonceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// EthAuthorizeNonceReplyMultiError is an error wrapping multiple validation
+// errors returned by EthAuthorizeNonceReply.ValidateAll() if the designated
+// constraints aren't met.
+type EthAuthorizeNonceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EthAuthorizeNonceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EthAuthorizeNonceReplyMultiError) AllErrors() []error { return m }
+
+// EthAuthorizeNonceReplyValidationError is the validation error returned by
+// EthAuthorizeNonceReply.Validate if the designated constraints aren't met.
+type EthAuthorizeNonceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EthAuthorizeNonceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EthAuthorizeNonceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EthAuthorizeNonceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EthAuthorizeNonceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EthAuthorizeNonceReplyValidationError) ErrorName() string {
+	return "EthAuthorizeNonceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EthAuthorizeNonceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEthAuthorizeNonceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EthAuthorizeNonceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EthAuthorizeNonceReplyValidationError{}
+
 // Validate checks the field values on EthAuthorizeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -645,6 +855,42 @@ func (m *UserInfoReply) validate(all bool) error {
 
 	// no validation rules for UserCount
 
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DepositAddress
+
 	if len(errors) > 0 {
 		return UserInfoReplyMultiError(errors)
 	}
@@ -747,6 +993,16 @@ func (m *RewardListRequest) validate(all bool) error {
 
 	// no validation rules for Type
 
+	// no validation rules for Reason
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
 	if len(errors) > 0 {
 		return RewardListRequestMultiError(errors)
 	}
@@ -883,6 +1139,12 @@ func (m *RewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Count
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
 		return RewardListReplyMultiError(errors)
 	}
@@ -983,6 +1245,18 @@ func (m *RecommendRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
 	if len(errors) > 0 {
 		return RecommendRewardListRequestMultiError(errors)
 	}
@@ -1119,6 +1393,12 @@ func (m *RecommendRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Count
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
 		return RecommendRewardListReplyMultiError(errors)
 	}
@@ -1221,6 +1501,18 @@ func (m *FeeRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
 	if len(errors) > 0 {
 		return FeeRewardListRequestMultiError(errors)
 	}
@@ -1357,6 +1649,12 @@ func (m *FeeRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Count
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
 		return FeeRewardListReplyMultiError(errors)
 	}
@@ -1917,71 +2215,42 @@ var _ interface {
 	ErrorName() string
 } = RecommendListReplyValidationError{}
 
-// Validate checks the field values on WithdrawRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest) Validate() error {
+// Validate checks the field values on ProductListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProductListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest with the rules
+// ValidateAll checks the field values on ProductListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawRequestMultiError, or nil if none found.
-func (m *WithdrawRequest) ValidateAll() error {
+// ProductListRequestMultiError, or nil if none found.
+func (m *ProductListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest) validate(all bool) error {
+func (m *ProductListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WithdrawRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return WithdrawRequestMultiError(errors)
+		return ProductListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequestMultiError is an error wrapping multiple validation errors
-// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// ProductListRequestMultiError is an error wrapping multiple validation errors
+// returned by ProductListRequest.ValidateAll() if the designated constraints
 // aren't met.
-type WithdrawRequestMultiError []error
+type ProductListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequestMultiError) Error() string {
+func (m ProductListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1990,11 +2259,11 @@ func (m WithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+func (m ProductListRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawRequestValidationError is the validation error returned by
-// WithdrawRequest.Validate if the designated constraints aren't met.
-type WithdrawRequestValidationError struct {
+// ProductListRequestValidationError is the validation error returned by
+// ProductListRequest.Validate if the designated constraints aren't met.
+type ProductListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2002,22 +2271,24 @@ type WithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequestValidationError) Field() string { return e.field }
+func (e ProductListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+func (e ProductListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+func (e ProductListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequestValidationError) Key() bool { return e.key }
+func (e ProductListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+func (e ProductListRequestValidationError) ErrorName() string {
+	return "ProductListRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequestValidationError) Error() string {
+func (e ProductListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2029,14 +2300,14 @@ func (e WithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest.%s: %s%s",
+		"invalid %sProductListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequestValidationError{}
+var _ error = ProductListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2044,46 +2315,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequestValidationError{}
+} = ProductListRequestValidationError{}
 
-// Validate checks the field values on WithdrawReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WithdrawReply) Validate() error {
+// Validate checks the field values on ProductListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProductListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
-// nil if none found.
-func (m *WithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on ProductListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductListReplyMultiError, or nil if none found.
+func (m *ProductListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawReply) validate(all bool) error {
+func (m *ProductListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProductListReplyValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SelectId
 
 	if len(errors) > 0 {
-		return WithdrawReplyMultiError(errors)
+		return ProductListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
+// ProductListReplyMultiError is an error wrapping multiple validation errors
+// returned by ProductListReply.ValidateAll() if the designated constraints
 // aren't met.
-type WithdrawReplyMultiError []error
+type ProductListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawReplyMultiError) Error() string {
+func (m ProductListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2092,11 +2397,11 @@ func (m WithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+func (m ProductListReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawReplyValidationError is the validation error returned by
-// WithdrawReply.Validate if the designated constraints aren't met.
-type WithdrawReplyValidationError struct {
+// ProductListReplyValidationError is the validation error returned by
+// ProductListReply.Validate if the designated constraints aren't met.
+type ProductListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2104,22 +2409,22 @@ type WithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawReplyValidationError) Field() string { return e.field }
+func (e ProductListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+func (e ProductListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+func (e ProductListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawReplyValidationError) Key() bool { return e.key }
+func (e ProductListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+func (e ProductListReplyValidationError) ErrorName() string { return "ProductListReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WithdrawReplyValidationError) Error() string {
+func (e ProductListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2131,14 +2436,14 @@ func (e WithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawReply.%s: %s%s",
+		"invalid %sProductListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawReplyValidationError{}
+var _ error = ProductListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2146,50 +2451,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawReplyValidationError{}
+} = ProductListReplyValidationError{}
 
-// Validate checks the field values on AdminRewardListRequest with the rules
+// Validate checks the field values on DepositProductRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListRequest) Validate() error {
+func (m *DepositProductRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// ValidateAll checks the field values on DepositProductRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListRequestMultiError, or nil if none found.
-func (m *AdminRewardListRequest) ValidateAll() error {
+// DepositProductRequestMultiError, or nil if none found.
+func (m *DepositProductRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListRequest) validate(all bool) error {
+func (m *DepositProductRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
-
-	// no validation rules for Reason
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepositProductRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepositProductRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepositProductRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminRewardListRequestMultiError(errors)
+		return DepositProductRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// DepositProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DepositProductRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListRequestMultiError []error
+type DepositProductRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListRequestMultiError) Error() string {
+func (m DepositProductRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2198,11 +2526,11 @@ func (m AdminRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+func (m DepositProductRequestMultiError) AllErrors() []error { return m }
 
-// AdminRewardListRequestValidationError is the validation error returned by
-// AdminRewardListRequest.Validate if the designated constraints aren't met.
-type AdminRewardListRequestValidationError struct {
+// DepositProductRequestValidationError is the validation error returned by
+// DepositProductRequest.Validate if the designated constraints aren't met.
+type DepositProductRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2210,24 +2538,24 @@ type AdminRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+func (e DepositProductRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+func (e DepositProductRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+func (e DepositProductRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+func (e DepositProductRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListRequestValidationError) ErrorName() string {
-	return "AdminRewardListRequestValidationError"
+func (e DepositProductRequestValidationError) ErrorName() string {
+	return "DepositProductRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListRequestValidationError) Error() string {
+func (e DepositProductRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2239,14 +2567,14 @@ func (e AdminRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListRequest.%s: %s%s",
+		"invalid %sDepositProductRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListRequestValidationError{}
+var _ error = DepositProductRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2254,80 +2582,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListRequestValidationError{}
+} = DepositProductRequestValidationError{}
 
-// Validate checks the field values on AdminRewardListReply with the rules
+// Validate checks the field values on DepositProductReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply) Validate() error {
+func (m *DepositProductReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply with the rules
+// ValidateAll checks the field values on DepositProductReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReplyMultiError, or nil if none found.
-func (m *AdminRewardListReply) ValidateAll() error {
+// DepositProductReplyMultiError, or nil if none found.
+func (m *DepositProductReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply) validate(all bool) error {
+func (m *DepositProductReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminRewardListReplyMultiError(errors)
+		return DepositProductReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// DepositProductReplyMultiError is an error wrapping multiple validation
+// errors returned by DepositProductReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListReplyMultiError []error
+type DepositProductReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReplyMultiError) Error() string {
+func (m DepositProductReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2336,11 +2628,11 @@ func (m AdminRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+func (m DepositProductReplyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReplyValidationError is the validation error returned by
-// AdminRewardListReply.Validate if the designated constraints aren't met.
-type AdminRewardListReplyValidationError struct {
+// DepositProductReplyValidationError is the validation error returned by
+// DepositProductReply.Validate if the designated constraints aren't met.
+type DepositProductReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2348,24 +2640,24 @@ type AdminRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+func (e DepositProductReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+func (e DepositProductReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+func (e DepositProductReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+func (e DepositProductReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReplyValidationError) ErrorName() string {
-	return "AdminRewardListReplyValidationError"
+func (e DepositProductReplyValidationError) ErrorName() string {
+	return "DepositProductReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReplyValidationError) Error() string {
+func (e DepositProductReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2377,14 +2669,14 @@ func (e AdminRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply.%s: %s%s",
+		"invalid %sDepositProductReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReplyValidationError{}
+var _ error = DepositProductReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2392,44 +2684,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReplyValidationError{}
+} = DepositProductReplyValidationError{}
 
-// Validate checks the field values on LockSystemRequest with the rules defined
+// Validate checks the field values on WithdrawRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *LockSystemRequest) Validate() error {
+func (m *WithdrawRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LockSystemRequest with the rules
+// ValidateAll checks the field values on WithdrawRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LockSystemRequestMultiError, or nil if none found.
-func (m *LockSystemRequest) ValidateAll() error {
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LockSystemRequest) validate(all bool) error {
+func (m *WithdrawRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return LockSystemRequestMultiError(errors)
+		return WithdrawRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LockSystemRequestMultiError is an error wrapping multiple validation errors
-// returned by LockSystemRequest.ValidateAll() if the designated constraints
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
 // aren't met.
-type LockSystemRequestMultiError []error
+type WithdrawRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LockSystemRequestMultiError) Error() string {
+func (m WithdrawRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2438,11 +2759,11 @@ func (m LockSystemRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LockSystemRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
 
-// LockSystemRequestValidationError is the validation error returned by
-// LockSystemRequest.Validate if the designated constraints aren't met.
-type LockSystemRequestValidationError struct {
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2450,24 +2771,22 @@ type LockSystemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LockSystemRequestValidationError) Field() string { return e.field }
+func (e WithdrawRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LockSystemRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LockSystemRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LockSystemRequestValidationError) Key() bool { return e.key }
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LockSystemRequestValidationError) ErrorName() string {
-	return "LockSystemRequestValidationError"
-}
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LockSystemRequestValidationError) Error() string {
+func (e WithdrawRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2479,14 +2798,14 @@ func (e LockSystemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLockSystemRequest.%s: %s%s",
+		"invalid %sWithdrawRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LockSystemRequestValidationError{}
+var _ error = WithdrawRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2494,44 +2813,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LockSystemRequestValidationError{}
+} = WithdrawRequestValidationError{}
 
-// Validate checks the field values on LockSystemReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *LockSystemReply) Validate() error {
+// Validate checks the field values on WithdrawReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WithdrawReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LockSystemReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LockSystemReplyMultiError, or nil if none found.
-func (m *LockSystemReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
+// nil if none found.
+func (m *WithdrawReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LockSystemReply) validate(all bool) error {
+func (m *WithdrawReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return LockSystemReplyMultiError(errors)
+		return WithdrawReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// LockSystemReplyMultiError is an error wrapping multiple validation errors
-// returned by LockSystemReply.ValidateAll() if the designated constraints
+// WithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawReply.ValidateAll() if the designated constraints
 // aren't met.
-type LockSystemReplyMultiError []error
+type WithdrawReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LockSystemReplyMultiError) Error() string {
+func (m WithdrawReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2540,11 +2861,11 @@ func (m LockSystemReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LockSystemReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawReplyMultiError) AllErrors() []error { return m }
 
-// LockSystemReplyValidationError is the validation error returned by
-// LockSystemReply.Validate if the designated constraints aren't met.
-type LockSystemReplyValidationError struct {
+// WithdrawReplyValidationError is the validation error returned by
+// WithdrawReply.Validate if the designated constraints aren't met.
+type WithdrawReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2552,22 +2873,22 @@ type LockSystemReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e LockSystemReplyValidationError) Field() string { return e.field }
+func (e WithdrawReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LockSystemReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LockSystemReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LockSystemReplyValidationError) Key() bool { return e.key }
+func (e WithdrawReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LockSystemReplyValidationError) ErrorName() string { return "LockSystemReplyValidationError" }
+func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LockSystemReplyValidationError) Error() string {
+func (e WithdrawReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2579,14 +2900,14 @@ func (e LockSystemReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLockSystemReply.%s: %s%s",
+		"invalid %sWithdrawReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LockSystemReplyValidationError{}
+var _ error = WithdrawReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2594,44 +2915,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LockSystemReplyValidationError{}
+} = WithdrawReplyValidationError{}
 
-// Validate checks the field values on CheckAdminUserAreaRequest with the rules
+// Validate checks the field values on AdminRewardListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckAdminUserAreaRequest) Validate() error {
+func (m *AdminRewardListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAdminUserAreaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckAdminUserAreaRequestMultiError, or nil if none found.
-func (m *CheckAdminUserAreaRequest) ValidateAll() error {
+// AdminRewardListRequestMultiError, or nil if none found.
+func (m *AdminRewardListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAdminUserAreaRequest) validate(all bool) error {
+func (m *AdminRewardListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return CheckAdminUserAreaRequestMultiError(errors)
+		return AdminRewardListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAdminUserAreaRequestMultiError is an error wrapping multiple validation
-// errors returned by CheckAdminUserAreaRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CheckAdminUserAreaRequestMultiError []error
+// AdminRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAdminUserAreaRequestMultiError) Error() string {
+func (m AdminRewardListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2640,11 +2967,11 @@ func (m CheckAdminUserAreaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAdminUserAreaRequestMultiError) AllErrors() []error { return m }
+func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
 
-// CheckAdminUserAreaRequestValidationError is the validation error returned by
-// CheckAdminUserAreaRequest.Validate if the designated constraints aren't met.
-type CheckAdminUserAreaRequestValidationError struct {
+// AdminRewardListRequestValidationError is the validation error returned by
+// AdminRewardListRequest.Validate if the designated constraints aren't met.
+type AdminRewardListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2652,24 +2979,24 @@ type CheckAdminUserAreaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAdminUserAreaRequestValidationError) Field() string { return e.field }
+func (e AdminRewardListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAdminUserAreaRequestValidationError) Reason() string { return e.reason }
+func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAdminUserAreaRequestValidationError) Cause() error { return e.cause }
+func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAdminUserAreaRequestValidationError) Key() bool { return e.key }
+func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAdminUserAreaRequestValidationError) ErrorName() string {
-	return "CheckAdminUserAreaRequestValidationError"
+func (e AdminRewardListRequestValidationError) ErrorName() string {
+	return "AdminRewardListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAdminUserAreaRequestValidationError) Error() string {
+func (e AdminRewardListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2681,14 +3008,14 @@ func (e CheckAdminUserAreaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAdminUserAreaRequest.%s: %s%s",
+		"invalid %sAdminRewardListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAdminUserAreaRequestValidationError{}
+var _ error = AdminRewardListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2696,44 +3023,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAdminUserAreaRequestValidationError{}
+} = AdminRewardListRequestValidationError{}
 
-// Validate checks the field values on CheckAdminUserAreaReply with the rules
+// Validate checks the field values on AdminRewardListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckAdminUserAreaReply) Validate() error {
+func (m *AdminRewardListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAdminUserAreaReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckAdminUserAreaReplyMultiError, or nil if none found.
-func (m *CheckAdminUserAreaReply) ValidateAll() error {
+// AdminRewardListReplyMultiError, or nil if none found.
+func (m *AdminRewardListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAdminUserAreaReply) validate(all bool) error {
+func (m *AdminRewardListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return CheckAdminUserAreaReplyMultiError(errors)
+		return AdminRewardListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAdminUserAreaReplyMultiError is an error wrapping multiple validation
-// errors returned by CheckAdminUserAreaReply.ValidateAll() if the designated
+// AdminRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply.ValidateAll() if the designated
 // constraints aren't met.
-type CheckAdminUserAreaReplyMultiError []error
+type AdminRewardListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAdminUserAreaReplyMultiError) Error() string {
+func (m AdminRewardListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2742,11 +3105,11 @@ func (m CheckAdminUserAreaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAdminUserAreaReplyMultiError) AllErrors() []error { return m }
+func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
 
-// CheckAdminUserAreaReplyValidationError is the validation error returned by
-// CheckAdminUserAreaReply.Validate if the designated constraints aren't met.
-type CheckAdminUserAreaReplyValidationError struct {
+// AdminRewardListReplyValidationError is the validation error returned by
+// AdminRewardListReply.Validate if the designated constraints aren't met.
+type AdminRewardListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2754,24 +3117,24 @@ type CheckAdminUserAreaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAdminUserAreaReplyValidationError) Field() string { return e.field }
+func (e AdminRewardListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAdminUserAreaReplyValidationError) Reason() string { return e.reason }
+func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAdminUserAreaReplyValidationError) Cause() error { return e.cause }
+func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAdminUserAreaReplyValidationError) Key() bool { return e.key }
+func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAdminUserAreaReplyValidationError) ErrorName() string {
-	return "CheckAdminUserAreaReplyValidationError"
+func (e AdminRewardListReplyValidationError) ErrorName() string {
+	return "AdminRewardListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAdminUserAreaReplyValidationError) Error() string {
+func (e AdminRewardListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2783,14 +3146,14 @@ func (e CheckAdminUserAreaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAdminUserAreaReply.%s: %s%s",
+		"invalid %sAdminRewardListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAdminUserAreaReplyValidationError{}
+var _ error = AdminRewardListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2798,26 +3161,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAdminUserAreaReplyValidationError{}
+} = AdminRewardListReplyValidationError{}
 
-// Validate checks the field values on
-// CheckAndInsertLocationsRecommendUserRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CheckAndInsertLocationsRecommendUserRequest) Validate() error {
+// Validate checks the field values on LockSystemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LockSystemRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckAndInsertLocationsRecommendUserRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckAndInsertLocationsRecommendUserRequestMultiError, or nil if none found.
-func (m *CheckAndInsertLocationsRecommendUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on LockSystemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockSystemRequestMultiError, or nil if none found.
+func (m *LockSystemRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertLocationsRecommendUserRequest) validate(all bool) error {
+func (m *LockSystemRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2825,20 +3186,19 @@ func (m *CheckAndInsertLocationsRecommendUserRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CheckAndInsertLocationsRecommendUserRequestMultiError(errors)
+		return LockSystemRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertLocationsRecommendUserRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckAndInsertLocationsRecommendUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CheckAndInsertLocationsRecommendUserRequestMultiError []error
+// LockSystemRequestMultiError is an error wrapping multiple validation errors
+// returned by LockSystemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LockSystemRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertLocationsRecommendUserRequestMultiError) Error() string {
+func (m LockSystemRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2847,12 +3207,11 @@ func (m CheckAndInsertLocationsRecommendUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertLocationsRecommendUserRequestMultiError) AllErrors() []error { return m }
+func (m LockSystemRequestMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertLocationsRecommendUserRequestValidationError is the validation
-// error returned by CheckAndInsertLocationsRecommendUserRequest.Validate if
-// the designated constraints aren't met.
-type CheckAndInsertLocationsRecommendUserRequestValidationError struct {
+// LockSystemRequestValidationError is the validation error returned by
+// LockSystemRequest.Validate if the designated constraints aren't met.
+type LockSystemRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2860,24 +3219,24 @@ type CheckAndInsertLocationsRecommendUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Field() string { return e.field }
+func (e LockSystemRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Reason() string { return e.reason }
+func (e LockSystemRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Cause() error { return e.cause }
+func (e LockSystemRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Key() bool { return e.key }
+func (e LockSystemRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) ErrorName() string {
-	return "CheckAndInsertLocationsRecommendUserRequestValidationError"
+func (e LockSystemRequestValidationError) ErrorName() string {
+	return "LockSystemRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Error() string {
+func (e LockSystemRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2889,14 +3248,14 @@ func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Error() stri
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertLocationsRecommendUserRequest.%s: %s%s",
+		"invalid %sLockSystemRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertLocationsRecommendUserRequestValidationError{}
+var _ error = LockSystemRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2904,26 +3263,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertLocationsRecommendUserRequestValidationError{}
+} = LockSystemRequestValidationError{}
 
-// Validate checks the field values on
-// CheckAndInsertLocationsRecommendUserReply with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CheckAndInsertLocationsRecommendUserReply) Validate() error {
+// Validate checks the field values on LockSystemReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LockSystemReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckAndInsertLocationsRecommendUserReply with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckAndInsertLocationsRecommendUserReplyMultiError, or nil if none found.
-func (m *CheckAndInsertLocationsRecommendUserReply) ValidateAll() error {
+// ValidateAll checks the field values on LockSystemReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockSystemReplyMultiError, or nil if none found.
+func (m *LockSystemReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertLocationsRecommendUserReply) validate(all bool) error {
+func (m *LockSystemReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2931,20 +3288,19 @@ func (m *CheckAndInsertLocationsRecommendUserReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CheckAndInsertLocationsRecommendUserReplyMultiError(errors)
+		return LockSystemReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertLocationsRecommendUserReplyMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckAndInsertLocationsRecommendUserReply.ValidateAll() if the designated
-// constraints aren't met.
-type CheckAndInsertLocationsRecommendUserReplyMultiError []error
+// LockSystemReplyMultiError is an error wrapping multiple validation errors
+// returned by LockSystemReply.ValidateAll() if the designated constraints
+// aren't met.
+type LockSystemReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertLocationsRecommendUserReplyMultiError) Error() string {
+func (m LockSystemReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2953,12 +3309,11 @@ func (m CheckAndInsertLocationsRecommendUserReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertLocationsRecommendUserReplyMultiError) AllErrors() []error { return m }
+func (m LockSystemReplyMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertLocationsRecommendUserReplyValidationError is the validation
-// error returned by CheckAndInsertLocationsRecommendUserReply.Validate if the
-// designated constraints aren't met.
-type CheckAndInsertLocationsRecommendUserReplyValidationError struct {
+// LockSystemReplyValidationError is the validation error returned by
+// LockSystemReply.Validate if the designated constraints aren't met.
+type LockSystemReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2966,24 +3321,22 @@ type CheckAndInsertLocationsRecommendUserReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Field() string { return e.field }
+func (e LockSystemReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Reason() string { return e.reason }
+func (e LockSystemReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Cause() error { return e.cause }
+func (e LockSystemReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Key() bool { return e.key }
+func (e LockSystemReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) ErrorName() string {
-	return "CheckAndInsertLocationsRecommendUserReplyValidationError"
-}
+func (e LockSystemReplyValidationError) ErrorName() string { return "LockSystemReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Error() string {
+func (e LockSystemReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2995,14 +3348,14 @@ func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertLocationsRecommendUserReply.%s: %s%s",
+		"invalid %sLockSystemReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertLocationsRecommendUserReplyValidationError{}
+var _ error = LockSystemReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3010,52 +3363,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertLocationsRecommendUserReplyValidationError{}
+} = LockSystemReplyValidationError{}
 
-// Validate checks the field values on AdminUserListRequest with the rules
+// Validate checks the field values on CheckAdminUserAreaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListRequest) Validate() error {
+func (m *CheckAdminUserAreaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckAdminUserAreaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListRequestMultiError, or nil if none found.
-func (m *AdminUserListRequest) ValidateAll() error {
+// CheckAdminUserAreaRequestMultiError, or nil if none found.
+func (m *CheckAdminUserAreaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListRequest) validate(all bool) error {
+func (m *CheckAdminUserAreaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
-
-	// no validation rules for IsLocation
-
-	// no validation rules for Vip
-
 	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+		return CheckAdminUserAreaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserListRequestMultiError []error
+// CheckAdminUserAreaRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckAdminUserAreaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CheckAdminUserAreaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
+func (m CheckAdminUserAreaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3064,11 +3409,11 @@ func (m AdminUserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+func (m CheckAdminUserAreaRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserListRequestValidationError is the validation error returned by
-// AdminUserListRequest.Validate if the designated constraints aren't met.
-type AdminUserListRequestValidationError struct {
+// CheckAdminUserAreaRequestValidationError is the validation error returned by
+// CheckAdminUserAreaRequest.Validate if the designated constraints aren't met.
+type CheckAdminUserAreaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3076,24 +3421,24 @@ type AdminUserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListRequestValidationError) Field() string { return e.field }
+func (e CheckAdminUserAreaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+func (e CheckAdminUserAreaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+func (e CheckAdminUserAreaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+func (e CheckAdminUserAreaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListRequestValidationError) ErrorName() string {
-	return "AdminUserListRequestValidationError"
+func (e CheckAdminUserAreaRequestValidationError) ErrorName() string {
+	return "CheckAdminUserAreaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListRequestValidationError) Error() string {
+func (e CheckAdminUserAreaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3105,14 +3450,14 @@ func (e AdminUserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListRequest.%s: %s%s",
+		"invalid %sCheckAdminUserAreaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListRequestValidationError{}
+var _ error = CheckAdminUserAreaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3120,80 +3465,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListRequestValidationError{}
+} = CheckAdminUserAreaRequestValidationError{}
 
-// Validate checks the field values on AdminUserListReply with the rules
+// Validate checks the field values on CheckAdminUserAreaReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply) Validate() error {
+func (m *CheckAdminUserAreaReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckAdminUserAreaReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReplyMultiError, or nil if none found.
-func (m *AdminUserListReply) ValidateAll() error {
+// CheckAdminUserAreaReplyMultiError, or nil if none found.
+func (m *CheckAdminUserAreaReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply) validate(all bool) error {
+func (m *CheckAdminUserAreaReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserListReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminUserListReplyMultiError(errors)
+		return CheckAdminUserAreaReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminUserListReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminUserListReplyMultiError []error
+// CheckAdminUserAreaReplyMultiError is an error wrapping multiple validation
+// errors returned by CheckAdminUserAreaReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAdminUserAreaReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReplyMultiError) Error() string {
+func (m CheckAdminUserAreaReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3202,11 +3511,11 @@ func (m AdminUserListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+func (m CheckAdminUserAreaReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserListReplyValidationError is the validation error returned by
-// AdminUserListReply.Validate if the designated constraints aren't met.
-type AdminUserListReplyValidationError struct {
+// CheckAdminUserAreaReplyValidationError is the validation error returned by
+// CheckAdminUserAreaReply.Validate if the designated constraints aren't met.
+type CheckAdminUserAreaReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3214,24 +3523,24 @@ type AdminUserListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReplyValidationError) Field() string { return e.field }
+func (e CheckAdminUserAreaReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+func (e CheckAdminUserAreaReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+func (e CheckAdminUserAreaReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+func (e CheckAdminUserAreaReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReplyValidationError) ErrorName() string {
-	return "AdminUserListReplyValidationError"
+func (e CheckAdminUserAreaReplyValidationError) ErrorName() string {
+	return "CheckAdminUserAreaReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReplyValidationError) Error() string {
+func (e CheckAdminUserAreaReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3243,14 +3552,14 @@ func (e AdminUserListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply.%s: %s%s",
+		"invalid %sCheckAdminUserAreaReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReplyValidationError{}
+var _ error = CheckAdminUserAreaReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3258,48 +3567,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReplyValidationError{}
+} = CheckAdminUserAreaReplyValidationError{}
 
-// Validate checks the field values on AdminLocationListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListRequest) Validate() error {
+// Validate checks the field values on
+// CheckAndInsertLocationsRecommendUserRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckAndInsertLocationsRecommendUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationListRequestMultiError, or nil if none found.
-func (m *AdminLocationListRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// CheckAndInsertLocationsRecommendUserRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckAndInsertLocationsRecommendUserRequestMultiError, or nil if none found.
+func (m *CheckAndInsertLocationsRecommendUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListRequest) validate(all bool) error {
+func (m *CheckAndInsertLocationsRecommendUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
-
 	if len(errors) > 0 {
-		return AdminLocationListRequestMultiError(errors)
+		return CheckAndInsertLocationsRecommendUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// CheckAndInsertLocationsRecommendUserRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckAndInsertLocationsRecommendUserRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListRequestMultiError []error
+type CheckAndInsertLocationsRecommendUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListRequestMultiError) Error() string {
+func (m CheckAndInsertLocationsRecommendUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3308,11 +3616,12 @@ func (m AdminLocationListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+func (m CheckAndInsertLocationsRecommendUserRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationListRequestValidationError is the validation error returned by
-// AdminLocationListRequest.Validate if the designated constraints aren't met.
-type AdminLocationListRequestValidationError struct {
+// CheckAndInsertLocationsRecommendUserRequestValidationError is the validation
+// error returned by CheckAndInsertLocationsRecommendUserRequest.Validate if
+// the designated constraints aren't met.
+type CheckAndInsertLocationsRecommendUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3320,24 +3629,24 @@ type AdminLocationListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListRequestValidationError) ErrorName() string {
-	return "AdminLocationListRequestValidationError"
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) ErrorName() string {
+	return "CheckAndInsertLocationsRecommendUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListRequestValidationError) Error() string {
+func (e CheckAndInsertLocationsRecommendUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3349,14 +3658,14 @@ func (e AdminLocationListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListRequest.%s: %s%s",
+		"invalid %sCheckAndInsertLocationsRecommendUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListRequestValidationError{}
+var _ error = CheckAndInsertLocationsRecommendUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3364,80 +3673,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListRequestValidationError{}
+} = CheckAndInsertLocationsRecommendUserRequestValidationError{}
 
-// Validate checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListReply) Validate() error {
+// Validate checks the field values on
+// CheckAndInsertLocationsRecommendUserReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckAndInsertLocationsRecommendUserReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationListReplyMultiError, or nil if none found.
-func (m *AdminLocationListReply) ValidateAll() error {
+// ValidateAll checks the field values on
+// CheckAndInsertLocationsRecommendUserReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckAndInsertLocationsRecommendUserReplyMultiError, or nil if none found.
+func (m *CheckAndInsertLocationsRecommendUserReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply) validate(all bool) error {
+func (m *CheckAndInsertLocationsRecommendUserReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminLocationListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminLocationListReplyMultiError(errors)
+		return CheckAndInsertLocationsRecommendUserReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// CheckAndInsertLocationsRecommendUserReplyMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckAndInsertLocationsRecommendUserReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListReplyMultiError []error
+type CheckAndInsertLocationsRecommendUserReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReplyMultiError) Error() string {
+func (m CheckAndInsertLocationsRecommendUserReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3446,11 +3722,12 @@ func (m AdminLocationListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+func (m CheckAndInsertLocationsRecommendUserReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReplyValidationError is the validation error returned by
-// AdminLocationListReply.Validate if the designated constraints aren't met.
-type AdminLocationListReplyValidationError struct {
+// CheckAndInsertLocationsRecommendUserReplyValidationError is the validation
+// error returned by CheckAndInsertLocationsRecommendUserReply.Validate if the
+// designated constraints aren't met.
+type CheckAndInsertLocationsRecommendUserReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3458,24 +3735,24 @@ type AdminLocationListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReplyValidationError) ErrorName() string {
-	return "AdminLocationListReplyValidationError"
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) ErrorName() string {
+	return "CheckAndInsertLocationsRecommendUserReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReplyValidationError) Error() string {
+func (e CheckAndInsertLocationsRecommendUserReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3487,14 +3764,14 @@ func (e AdminLocationListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply.%s: %s%s",
+		"invalid %sCheckAndInsertLocationsRecommendUserReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReplyValidationError{}
+var _ error = CheckAndInsertLocationsRecommendUserReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3502,24 +3779,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReplyValidationError{}
+} = CheckAndInsertLocationsRecommendUserReplyValidationError{}
 
-// Validate checks the field values on AdminLocationAllListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationAllListRequest) Validate() error {
+func (m *AdminUserListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationAllListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationAllListRequestMultiError, or nil if none found.
-func (m *AdminLocationAllListRequest) ValidateAll() error {
+// AdminUserListRequestMultiError, or nil if none found.
+func (m *AdminUserListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationAllListRequest) validate(all bool) error {
+func (m *AdminUserListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3530,20 +3807,24 @@ func (m *AdminLocationAllListRequest) validate(all bool) error {
 
 	// no validation rules for Address
 
+	// no validation rules for IsLocation
+
+	// no validation rules for Vip
+
 	if len(errors) > 0 {
-		return AdminLocationAllListRequestMultiError(errors)
+		return AdminUserListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationAllListRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminLocationAllListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminLocationAllListRequestMultiError []error
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationAllListRequestMultiError) Error() string {
+func (m AdminUserListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3552,12 +3833,11 @@ func (m AdminLocationAllListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationAllListRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationAllListRequestValidationError is the validation error returned
-// by AdminLocationAllListRequest.Validate if the designated constraints
-// aren't met.
-type AdminLocationAllListRequestValidationError struct {
+// AdminUserListRequestValidationError is the validation error returned by
+// AdminUserListRequest.Validate if the designated constraints aren't met.
+type AdminUserListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3565,24 +3845,24 @@ type AdminLocationAllListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationAllListRequestValidationError) Field() string { return e.field }
+func (e AdminUserListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationAllListRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationAllListRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationAllListRequestValidationError) Key() bool { return e.key }
+func (e AdminUserListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationAllListRequestValidationError) ErrorName() string {
-	return "AdminLocationAllListRequestValidationError"
+func (e AdminUserListRequestValidationError) ErrorName() string {
+	return "AdminUserListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationAllListRequestValidationError) Error() string {
+func (e AdminUserListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3594,14 +3874,14 @@ func (e AdminLocationAllListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationAllListRequest.%s: %s%s",
+		"invalid %sAdminUserListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationAllListRequestValidationError{}
+var _ error = AdminUserListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3609,47 +3889,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationAllListRequestValidationError{}
+} = AdminUserListRequestValidationError{}
 
-// Validate checks the field values on AdminLocationAllListReply with the rules
+// Validate checks the field values on AdminUserListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationAllListReply) Validate() error {
+func (m *AdminUserListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationAllListReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationAllListReplyMultiError, or nil if none found.
-func (m *AdminLocationAllListReply) ValidateAll() error {
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationAllListReply) validate(all bool) error {
+func (m *AdminUserListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
+	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationAllListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationAllListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3657,8 +3937,8 @@ func (m *AdminLocationAllListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminLocationAllListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3670,19 +3950,19 @@ func (m *AdminLocationAllListReply) validate(all bool) error {
 	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminLocationAllListReplyMultiError(errors)
+		return AdminUserListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationAllListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationAllListReply.ValidateAll() if the
-// designated constraints aren't met.
-type AdminLocationAllListReplyMultiError []error
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationAllListReplyMultiError) Error() string {
+func (m AdminUserListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3691,11 +3971,11 @@ func (m AdminLocationAllListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationAllListReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationAllListReplyValidationError is the validation error returned by
-// AdminLocationAllListReply.Validate if the designated constraints aren't met.
-type AdminLocationAllListReplyValidationError struct {
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3703,24 +3983,24 @@ type AdminLocationAllListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationAllListReplyValidationError) Field() string { return e.field }
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationAllListReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationAllListReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationAllListReplyValidationError) Key() bool { return e.key }
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationAllListReplyValidationError) ErrorName() string {
-	return "AdminLocationAllListReplyValidationError"
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationAllListReplyValidationError) Error() string {
+func (e AdminUserListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3732,14 +4012,14 @@ func (e AdminLocationAllListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationAllListReply.%s: %s%s",
+		"invalid %sAdminUserListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationAllListReplyValidationError{}
+var _ error = AdminUserListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3747,50 +4027,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationAllListReplyValidationError{}
+} = AdminUserListReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListRequest with the rules
+// Validate checks the field values on AdminLocationListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListRequest) Validate() error {
+func (m *AdminLocationListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// ValidateAll checks the field values on AdminLocationListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListRequestMultiError, or nil if none found.
-func (m *AdminWithdrawListRequest) ValidateAll() error {
+// AdminLocationListRequestMultiError, or nil if none found.
+func (m *AdminLocationListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListRequest) validate(all bool) error {
+func (m *AdminLocationListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for WithDrawType
-
 	// no validation rules for Page
 
 	// no validation rules for Address
 
 	if len(errors) > 0 {
-		return AdminWithdrawListRequestMultiError(errors)
+		return AdminLocationListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// AdminLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListRequestMultiError []error
+type AdminLocationListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListRequestMultiError) Error() string {
+func (m AdminLocationListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3799,11 +4077,11 @@ func (m AdminWithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListRequestValidationError is the validation error returned by
-// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawListRequestValidationError struct {
+// AdminLocationListRequestValidationError is the validation error returned by
+// AdminLocationListRequest.Validate if the designated constraints aren't met.
+type AdminLocationListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3811,24 +4089,24 @@ type AdminWithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+func (e AdminLocationListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListRequestValidationError) ErrorName() string {
-	return "AdminWithdrawListRequestValidationError"
+func (e AdminLocationListRequestValidationError) ErrorName() string {
+	return "AdminLocationListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListRequestValidationError) Error() string {
+func (e AdminLocationListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3840,14 +4118,14 @@ func (e AdminWithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		"invalid %sAdminLocationListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListRequestValidationError{}
+var _ error = AdminLocationListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3855,47 +4133,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListRequestValidationError{}
+} = AdminLocationListRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply with the rules
+// Validate checks the field values on AdminLocationListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply) Validate() error {
+func (m *AdminLocationListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// ValidateAll checks the field values on AdminLocationListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReplyMultiError, or nil if none found.
-func (m *AdminWithdrawListReply) ValidateAll() error {
+// AdminLocationListReplyMultiError, or nil if none found.
+func (m *AdminLocationListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply) validate(all bool) error {
+func (m *AdminLocationListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
+	for idx, item := range m.GetLocations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3903,8 +4181,8 @@ func (m *AdminWithdrawListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminWithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
+				return AdminLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3916,19 +4194,19 @@ func (m *AdminWithdrawListReply) validate(all bool) error {
 	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReplyMultiError(errors)
+		return AdminLocationListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// AdminLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListReplyMultiError []error
+type AdminLocationListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReplyMultiError) Error() string {
+func (m AdminLocationListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3937,11 +4215,11 @@ func (m AdminWithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReplyValidationError is the validation error returned by
-// AdminWithdrawListReply.Validate if the designated constraints aren't met.
-type AdminWithdrawListReplyValidationError struct {
+// AdminLocationListReplyValidationError is the validation error returned by
+// AdminLocationListReply.Validate if the designated constraints aren't met.
+type AdminLocationListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3949,24 +4227,24 @@ type AdminWithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+func (e AdminLocationListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReplyValidationError) ErrorName() string {
-	return "AdminWithdrawListReplyValidationError"
+func (e AdminLocationListReplyValidationError) ErrorName() string {
+	return "AdminLocationListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReplyValidationError) Error() string {
+func (e AdminLocationListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3978,14 +4256,14 @@ func (e AdminWithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		"invalid %sAdminLocationListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReplyValidationError{}
+var _ error = AdminLocationListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3993,44 +4271,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReplyValidationError{}
+} = AdminLocationListReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationAllListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawRequest) Validate() error {
+func (m *AdminLocationAllListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationAllListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawRequestMultiError, or nil if none found.
-func (m *AdminWithdrawRequest) ValidateAll() error {
+// AdminLocationAllListRequestMultiError, or nil if none found.
+func (m *AdminLocationAllListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawRequest) validate(all bool) error {
+func (m *AdminLocationAllListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Page
+
+	// no validation rules for Address
+
 	if len(errors) > 0 {
-		return AdminWithdrawRequestMultiError(errors)
+		return AdminLocationAllListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawRequestMultiError []error
+// AdminLocationAllListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationAllListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationAllListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawRequestMultiError) Error() string {
+func (m AdminLocationAllListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4039,11 +4321,12 @@ func (m AdminWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationAllListRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawRequestValidationError is the validation error returned by
-// AdminWithdrawRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawRequestValidationError struct {
+// AdminLocationAllListRequestValidationError is the validation error returned
+// by AdminLocationAllListRequest.Validate if the designated constraints
+// aren't met.
+type AdminLocationAllListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4051,24 +4334,24 @@ type AdminWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+func (e AdminLocationAllListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationAllListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationAllListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationAllListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawRequestValidationError) ErrorName() string {
-	return "AdminWithdrawRequestValidationError"
+func (e AdminLocationAllListRequestValidationError) ErrorName() string {
+	return "AdminLocationAllListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawRequestValidationError) Error() string {
+func (e AdminLocationAllListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4080,14 +4363,14 @@ func (e AdminWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		"invalid %sAdminLocationAllListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawRequestValidationError{}
+var _ error = AdminLocationAllListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4095,82 +4378,6811 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawRequestValidationError{}
+} = AdminLocationAllListRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawReply with the rules
+// Validate checks the field values on AdminLocationAllListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawReply) Validate() error {
+func (m *AdminLocationAllListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationAllListReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawReplyMultiError, or nil if none found.
-func (m *AdminWithdrawReply) ValidateAll() error {
+// AdminLocationAllListReplyMultiError, or nil if none found.
+func (m *AdminLocationAllListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawReply) validate(all bool) error {
+func (m *AdminLocationAllListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return AdminWithdrawReplyMultiError(errors)
-	}
-
-	return nil
-}
-
-// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminWithdrawReplyMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawReplyMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
 
-// AdminWithdrawReplyValidationError is the validation error returned by
-// AdminWithdrawReply.Validate if the designated constraints aren't met.
-type AdminWithdrawReplyValidationError struct {
-	field  string
-	reason string
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationAllListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationAllListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationAllListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLocationAllListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationAllListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationAllListReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminLocationAllListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationAllListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationAllListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationAllListReplyValidationError is the validation error returned by
+// AdminLocationAllListReply.Validate if the designated constraints aren't met.
+type AdminLocationAllListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationAllListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationAllListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationAllListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationAllListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationAllListReplyValidationError) ErrorName() string {
+	return "AdminLocationAllListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationAllListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationAllListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationAllListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationAllListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithDrawType
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminWithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListRequestValidationError is the validation error returned by
+// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReplyValidationError is the validation error returned by
+// AdminWithdrawListReply.Validate if the designated constraints aren't met.
+type AdminWithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRequestValidationError is the validation error returned by
+// AdminWithdrawRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReplyValidationError is the validation error returned by
+// AdminWithdrawReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawPassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawPassRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawPassRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawPassRequestMultiError, or nil if none found.
+func (m *AdminWithdrawPassRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawPassRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawPassRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawPassRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawPassRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawPassRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawPassRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawPassRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawPassRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawPassRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawPassRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawPassRequestValidationError is the validation error returned by
+// AdminWithdrawPassRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawPassRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawPassRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawPassRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawPassRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawPassRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawPassRequestValidationError) ErrorName() string {
+	return "AdminWithdrawPassRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawPassRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawPassRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawPassRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawPassRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawPassReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawPassReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawPassReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawPassReplyMultiError, or nil if none found.
+func (m *AdminWithdrawPassReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawPassReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawPassReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawPassReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawPassReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawPassReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawPassReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawPassReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawPassReplyValidationError is the validation error returned by
+// AdminWithdrawPassReply.Validate if the designated constraints aren't met.
+type AdminWithdrawPassReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawPassReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawPassReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawPassReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawPassReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawPassReplyValidationError) ErrorName() string {
+	return "AdminWithdrawPassReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawPassReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawPassReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawPassReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawPassReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRejectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRejectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectRequestValidationError is the validation error returned
+// by AdminWithdrawRejectRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRejectReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRejectReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectReplyValidationError is the validation error returned by
+// AdminWithdrawRejectReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRefundRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRefundRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRefundRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRefundRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRefundRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRefundRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRefundRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRefundRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRefundRequestValidationError is the validation error returned
+// by AdminWithdrawRefundRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRefundRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRefundRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRefundRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRefundReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRefundReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRefundReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRefundReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRefundReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRefundReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRefundReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRefundReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRefundReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRefundReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRefundReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRefundReplyValidationError is the validation error returned by
+// AdminWithdrawRefundReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRefundReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRefundReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRefundReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRefundReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRefundReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRefundReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRefundReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRefundReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRefundReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRefundReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRefundReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawTrackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawTrackRequestMultiError, or nil if none found.
+func (m *AdminWithdrawTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawTrackRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawTrackRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWithdrawTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawTrackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawTrackRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawTrackRequestValidationError is the validation error returned by
+// AdminWithdrawTrackRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawTrackRequestValidationError) ErrorName() string {
+	return "AdminWithdrawTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawTrackRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawTrackReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawTrackReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawTrackReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawTrackReplyMultiError, or nil if none found.
+func (m *AdminWithdrawTrackReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawTrackReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawTrackReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawTrackReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawTrackReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawTrackReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawTrackReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawTrackReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawTrackReplyValidationError is the validation error returned by
+// AdminWithdrawTrackReply.Validate if the designated constraints aren't met.
+type AdminWithdrawTrackReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawTrackReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawTrackReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawTrackReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawTrackReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawTrackReplyValidationError) ErrorName() string {
+	return "AdminWithdrawTrackReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawTrackReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawTrackReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawTrackReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawTrackReplyValidationError{}
+
+// Validate checks the field values on AdminWalletTxCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWalletTxCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWalletTxCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWalletTxCheckRequestMultiError, or nil if none found.
+func (m *AdminWalletTxCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWalletTxCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWalletTxCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWalletTxCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWalletTxCheckRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWalletTxCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWalletTxCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWalletTxCheckRequestMultiError) AllErrors() []error { return m }
+
+// AdminWalletTxCheckRequestValidationError is the validation error returned by
+// AdminWalletTxCheckRequest.Validate if the designated constraints aren't met.
+type AdminWalletTxCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWalletTxCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWalletTxCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWalletTxCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWalletTxCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWalletTxCheckRequestValidationError) ErrorName() string {
+	return "AdminWalletTxCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWalletTxCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWalletTxCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWalletTxCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWalletTxCheckRequestValidationError{}
+
+// Validate checks the field values on AdminWalletTxCheckReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWalletTxCheckReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWalletTxCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWalletTxCheckReplyMultiError, or nil if none found.
+func (m *AdminWalletTxCheckReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWalletTxCheckReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWalletTxCheckReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWalletTxCheckReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWalletTxCheckReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWalletTxCheckReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWalletTxCheckReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWalletTxCheckReplyMultiError) AllErrors() []error { return m }
+
+// AdminWalletTxCheckReplyValidationError is the validation error returned by
+// AdminWalletTxCheckReply.Validate if the designated constraints aren't met.
+type AdminWalletTxCheckReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWalletTxCheckReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWalletTxCheckReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWalletTxCheckReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWalletTxCheckReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWalletTxCheckReplyValidationError) ErrorName() string {
+	return "AdminWalletTxCheckReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWalletTxCheckReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWalletTxCheckReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWalletTxCheckReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWalletTxCheckReplyValidationError{}
+
+// Validate checks the field values on AdminSweepRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminSweepRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSweepRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSweepRequestMultiError, or nil if none found.
+func (m *AdminSweepRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSweepRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminSweepRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSweepRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminSweepRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSweepRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSweepRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSweepRequestMultiError) AllErrors() []error { return m }
+
+// AdminSweepRequestValidationError is the validation error returned by
+// AdminSweepRequest.Validate if the designated constraints aren't met.
+type AdminSweepRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSweepRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSweepRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSweepRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSweepRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSweepRequestValidationError) ErrorName() string {
+	return "AdminSweepRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSweepRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSweepRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSweepRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSweepRequestValidationError{}
+
+// Validate checks the field values on AdminSweepReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminSweepReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSweepReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSweepReplyMultiError, or nil if none found.
+func (m *AdminSweepReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSweepReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminSweepReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSweepReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminSweepReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSweepReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSweepReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSweepReplyMultiError) AllErrors() []error { return m }
+
+// AdminSweepReplyValidationError is the validation error returned by
+// AdminSweepReply.Validate if the designated constraints aren't met.
+type AdminSweepReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSweepReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSweepReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSweepReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSweepReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSweepReplyValidationError) ErrorName() string { return "AdminSweepReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSweepReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSweepReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSweepReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSweepReplyValidationError{}
+
+// Validate checks the field values on AdminDepositReorgCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgCheckRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgCheckRequestMultiError, or nil if none found.
+func (m *AdminDepositReorgCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDepositReorgCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgCheckRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgCheckRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositReorgCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgCheckRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgCheckRequestValidationError is the validation error
+// returned by AdminDepositReorgCheckRequest.Validate if the designated
+// constraints aren't met.
+type AdminDepositReorgCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgCheckRequestValidationError) ErrorName() string {
+	return "AdminDepositReorgCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgCheckRequestValidationError{}
+
+// Validate checks the field values on AdminDepositReorgCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgCheckReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgCheckReplyMultiError, or nil if none found.
+func (m *AdminDepositReorgCheckReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgCheckReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDepositReorgCheckReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgCheckReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgCheckReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReorgCheckReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgCheckReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgCheckReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgCheckReplyValidationError is the validation error returned
+// by AdminDepositReorgCheckReply.Validate if the designated constraints
+// aren't met.
+type AdminDepositReorgCheckReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgCheckReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgCheckReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgCheckReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgCheckReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgCheckReplyValidationError) ErrorName() string {
+	return "AdminDepositReorgCheckReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgCheckReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgCheckReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgCheckReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgCheckReplyValidationError{}
+
+// Validate checks the field values on AdminDepositReorgListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgListRequestMultiError, or nil if none found.
+func (m *AdminDepositReorgListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Reorg
+
+	if len(errors) > 0 {
+		return AdminDepositReorgListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReorgListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgListRequestValidationError is the validation error returned
+// by AdminDepositReorgListRequest.Validate if the designated constraints
+// aren't met.
+type AdminDepositReorgListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgListRequestValidationError) ErrorName() string {
+	return "AdminDepositReorgListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgListRequestValidationError{}
+
+// Validate checks the field values on AdminDepositReorgListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgListReplyMultiError, or nil if none found.
+func (m *AdminDepositReorgListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositReorgListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositReorgListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositReorgListReplyValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminDepositReorgListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReorgListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgListReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgListReplyValidationError is the validation error returned
+// by AdminDepositReorgListReply.Validate if the designated constraints aren't met.
+type AdminDepositReorgListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgListReplyValidationError) ErrorName() string {
+	return "AdminDepositReorgListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositReorgResolveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgResolveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgResolveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgResolveRequestMultiError, or nil if none found.
+func (m *AdminDepositReorgResolveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgResolveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositReorgResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositReorgResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositReorgResolveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositReorgResolveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgResolveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgResolveRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositReorgResolveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgResolveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgResolveRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgResolveRequestValidationError is the validation error
+// returned by AdminDepositReorgResolveRequest.Validate if the designated
+// constraints aren't met.
+type AdminDepositReorgResolveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgResolveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgResolveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgResolveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgResolveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgResolveRequestValidationError) ErrorName() string {
+	return "AdminDepositReorgResolveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgResolveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgResolveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgResolveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgResolveRequestValidationError{}
+
+// Validate checks the field values on AdminDepositReorgResolveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgResolveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgResolveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgResolveReplyMultiError, or nil if none found.
+func (m *AdminDepositReorgResolveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgResolveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDepositReorgResolveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgResolveReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgResolveReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositReorgResolveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgResolveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgResolveReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgResolveReplyValidationError is the validation error
+// returned by AdminDepositReorgResolveReply.Validate if the designated
+// constraints aren't met.
+type AdminDepositReorgResolveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgResolveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgResolveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgResolveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgResolveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgResolveReplyValidationError) ErrorName() string {
+	return "AdminDepositReorgResolveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgResolveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgResolveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgResolveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgResolveReplyValidationError{}
+
+// Validate checks the field values on AdminDepositLookupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositLookupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositLookupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupRequestMultiError, or nil if none found.
+func (m *AdminDepositLookupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositLookupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxHash
+
+	if len(errors) > 0 {
+		return AdminDepositLookupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositLookupRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositLookupRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDepositLookupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositLookupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositLookupRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositLookupRequestValidationError is the validation error returned by
+// AdminDepositLookupRequest.Validate if the designated constraints aren't met.
+type AdminDepositLookupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositLookupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositLookupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositLookupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositLookupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositLookupRequestValidationError) ErrorName() string {
+	return "AdminDepositLookupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositLookupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositLookupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositLookupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositLookupRequestValidationError{}
+
+// Validate checks the field values on AdminDepositLookupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositLookupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositLookupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReplyMultiError, or nil if none found.
+func (m *AdminDepositLookupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositLookupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositLookupReplyValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositLookupReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositLookupReplyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositLookupReplyValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositLookupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositLookupReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositLookupReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositLookupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositLookupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositLookupReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositLookupReplyValidationError is the validation error returned by
+// AdminDepositLookupReply.Validate if the designated constraints aren't met.
+type AdminDepositLookupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositLookupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositLookupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositLookupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositLookupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositLookupReplyValidationError) ErrorName() string {
+	return "AdminDepositLookupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositLookupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositLookupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositLookupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositLookupReplyValidationError{}
+
+// Validate checks the field values on AdminInboundTransferListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminInboundTransferListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInboundTransferListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminInboundTransferListRequestMultiError, or nil if none found.
+func (m *AdminInboundTransferListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInboundTransferListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Disposition
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return AdminInboundTransferListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInboundTransferListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminInboundTransferListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminInboundTransferListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInboundTransferListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInboundTransferListRequestMultiError) AllErrors() []error { return m }
+
+// AdminInboundTransferListRequestValidationError is the validation error
+// returned by AdminInboundTransferListRequest.Validate if the designated
+// constraints aren't met.
+type AdminInboundTransferListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInboundTransferListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInboundTransferListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInboundTransferListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInboundTransferListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInboundTransferListRequestValidationError) ErrorName() string {
+	return "AdminInboundTransferListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInboundTransferListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInboundTransferListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInboundTransferListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInboundTransferListRequestValidationError{}
+
+// Validate checks the field values on AdminInboundTransferListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminInboundTransferListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInboundTransferListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminInboundTransferListReplyMultiError, or nil if none found.
+func (m *AdminInboundTransferListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInboundTransferListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminInboundTransferListReplyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminInboundTransferListReplyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminInboundTransferListReplyValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminInboundTransferListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInboundTransferListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminInboundTransferListReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminInboundTransferListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInboundTransferListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInboundTransferListReplyMultiError) AllErrors() []error { return m }
+
+// AdminInboundTransferListReplyValidationError is the validation error
+// returned by AdminInboundTransferListReply.Validate if the designated
+// constraints aren't met.
+type AdminInboundTransferListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInboundTransferListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInboundTransferListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInboundTransferListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInboundTransferListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInboundTransferListReplyValidationError) ErrorName() string {
+	return "AdminInboundTransferListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInboundTransferListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInboundTransferListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInboundTransferListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInboundTransferListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositCreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositCreditRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositCreditRequestMultiError, or nil if none found.
+func (m *AdminDepositCreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositCreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositCreditRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositCreditRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositCreditRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositCreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositCreditRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositCreditRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDepositCreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositCreditRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositCreditRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositCreditRequestValidationError is the validation error returned by
+// AdminDepositCreditRequest.Validate if the designated constraints aren't met.
+type AdminDepositCreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositCreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositCreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositCreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositCreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositCreditRequestValidationError) ErrorName() string {
+	return "AdminDepositCreditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositCreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositCreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositCreditRequestValidationError{}
+
+// Validate checks the field values on AdminDepositCreditReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositCreditReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositCreditReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositCreditReplyMultiError, or nil if none found.
+func (m *AdminDepositCreditReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositCreditReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CoinType
+
+	// no validation rules for Disposition
+
+	if len(errors) > 0 {
+		return AdminDepositCreditReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositCreditReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositCreditReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositCreditReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositCreditReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositCreditReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositCreditReplyValidationError is the validation error returned by
+// AdminDepositCreditReply.Validate if the designated constraints aren't met.
+type AdminDepositCreditReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositCreditReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositCreditReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositCreditReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositCreditReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositCreditReplyValidationError) ErrorName() string {
+	return "AdminDepositCreditReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositCreditReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositCreditReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositCreditReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositCreditReplyValidationError{}
+
+// Validate checks the field values on AdminInboundTransferResolveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminInboundTransferResolveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInboundTransferResolveRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminInboundTransferResolveRequestMultiError, or nil if none found.
+func (m *AdminInboundTransferResolveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInboundTransferResolveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminInboundTransferResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminInboundTransferResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminInboundTransferResolveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminInboundTransferResolveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInboundTransferResolveRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminInboundTransferResolveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminInboundTransferResolveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInboundTransferResolveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInboundTransferResolveRequestMultiError) AllErrors() []error { return m }
+
+// AdminInboundTransferResolveRequestValidationError is the validation error
+// returned by AdminInboundTransferResolveRequest.Validate if the designated
+// constraints aren't met.
+type AdminInboundTransferResolveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInboundTransferResolveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInboundTransferResolveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInboundTransferResolveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInboundTransferResolveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInboundTransferResolveRequestValidationError) ErrorName() string {
+	return "AdminInboundTransferResolveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInboundTransferResolveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInboundTransferResolveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInboundTransferResolveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInboundTransferResolveRequestValidationError{}
+
+// Validate checks the field values on AdminInboundTransferResolveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminInboundTransferResolveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInboundTransferResolveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminInboundTransferResolveReplyMultiError, or nil if none found.
+func (m *AdminInboundTransferResolveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInboundTransferResolveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminInboundTransferResolveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInboundTransferResolveReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminInboundTransferResolveReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminInboundTransferResolveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInboundTransferResolveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInboundTransferResolveReplyMultiError) AllErrors() []error { return m }
+
+// AdminInboundTransferResolveReplyValidationError is the validation error
+// returned by AdminInboundTransferResolveReply.Validate if the designated
+// constraints aren't met.
+type AdminInboundTransferResolveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInboundTransferResolveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInboundTransferResolveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInboundTransferResolveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInboundTransferResolveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInboundTransferResolveReplyValidationError) ErrorName() string {
+	return "AdminInboundTransferResolveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInboundTransferResolveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInboundTransferResolveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInboundTransferResolveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInboundTransferResolveReplyValidationError{}
+
+// Validate checks the field values on AdminWalletTxReplaceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWalletTxReplaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWalletTxReplaceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWalletTxReplaceRequestMultiError, or nil if none found.
+func (m *AdminWalletTxReplaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWalletTxReplaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWalletTxReplaceRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWalletTxReplaceRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWalletTxReplaceRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWalletTxReplaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWalletTxReplaceRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWalletTxReplaceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWalletTxReplaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWalletTxReplaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWalletTxReplaceRequestMultiError) AllErrors() []error { return m }
+
+// AdminWalletTxReplaceRequestValidationError is the validation error returned
+// by AdminWalletTxReplaceRequest.Validate if the designated constraints
+// aren't met.
+type AdminWalletTxReplaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWalletTxReplaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWalletTxReplaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWalletTxReplaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWalletTxReplaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWalletTxReplaceRequestValidationError) ErrorName() string {
+	return "AdminWalletTxReplaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWalletTxReplaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWalletTxReplaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWalletTxReplaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWalletTxReplaceRequestValidationError{}
+
+// Validate checks the field values on AdminWalletTxReplaceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWalletTxReplaceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWalletTxReplaceReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWalletTxReplaceReplyMultiError, or nil if none found.
+func (m *AdminWalletTxReplaceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWalletTxReplaceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxHash
+
+	if len(errors) > 0 {
+		return AdminWalletTxReplaceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWalletTxReplaceReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWalletTxReplaceReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWalletTxReplaceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWalletTxReplaceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWalletTxReplaceReplyMultiError) AllErrors() []error { return m }
+
+// AdminWalletTxReplaceReplyValidationError is the validation error returned by
+// AdminWalletTxReplaceReply.Validate if the designated constraints aren't met.
+type AdminWalletTxReplaceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWalletTxReplaceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWalletTxReplaceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWalletTxReplaceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWalletTxReplaceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWalletTxReplaceReplyValidationError) ErrorName() string {
+	return "AdminWalletTxReplaceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWalletTxReplaceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWalletTxReplaceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWalletTxReplaceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWalletTxReplaceReplyValidationError{}
+
+// Validate checks the field values on AdminProductListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProductListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminProductListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProductListRequestMultiError, or nil if none found.
+func (m *AdminProductListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminProductListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminProductListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminProductListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminProductListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProductListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminProductListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminProductListRequestMultiError) AllErrors() []error { return m }
+
+// AdminProductListRequestValidationError is the validation error returned by
+// AdminProductListRequest.Validate if the designated constraints aren't met.
+type AdminProductListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminProductListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminProductListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminProductListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminProductListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminProductListRequestValidationError) ErrorName() string {
+	return "AdminProductListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminProductListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminProductListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminProductListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminProductListRequestValidationError{}
+
+// Validate checks the field values on AdminProductListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProductListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminProductListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProductListReplyMultiError, or nil if none found.
+func (m *AdminProductListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminProductListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminProductListReplyValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminProductListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminProductListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminProductListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProductListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminProductListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminProductListReplyMultiError) AllErrors() []error { return m }
+
+// AdminProductListReplyValidationError is the validation error returned by
+// AdminProductListReply.Validate if the designated constraints aren't met.
+type AdminProductListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminProductListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminProductListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminProductListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminProductListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminProductListReplyValidationError) ErrorName() string {
+	return "AdminProductListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminProductListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminProductListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminProductListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminProductListReplyValidationError{}
+
+// Validate checks the field values on AdminProductSaveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProductSaveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminProductSaveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProductSaveRequestMultiError, or nil if none found.
+func (m *AdminProductSaveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminProductSaveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminProductSaveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminProductSaveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminProductSaveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminProductSaveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminProductSaveRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminProductSaveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProductSaveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminProductSaveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminProductSaveRequestMultiError) AllErrors() []error { return m }
+
+// AdminProductSaveRequestValidationError is the validation error returned by
+// AdminProductSaveRequest.Validate if the designated constraints aren't met.
+type AdminProductSaveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminProductSaveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminProductSaveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminProductSaveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminProductSaveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminProductSaveRequestValidationError) ErrorName() string {
+	return "AdminProductSaveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminProductSaveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminProductSaveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminProductSaveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminProductSaveRequestValidationError{}
+
+// Validate checks the field values on AdminProductSaveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProductSaveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminProductSaveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProductSaveReplyMultiError, or nil if none found.
+func (m *AdminProductSaveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminProductSaveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminProductSaveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminProductSaveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminProductSaveReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProductSaveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminProductSaveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminProductSaveReplyMultiError) AllErrors() []error { return m }
+
+// AdminProductSaveReplyValidationError is the validation error returned by
+// AdminProductSaveReply.Validate if the designated constraints aren't met.
+type AdminProductSaveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminProductSaveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminProductSaveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminProductSaveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminProductSaveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminProductSaveReplyValidationError) ErrorName() string {
+	return "AdminProductSaveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminProductSaveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminProductSaveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminProductSaveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminProductSaveReplyValidationError{}
+
+// Validate checks the field values on AdminTokenListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTokenListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTokenListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTokenListRequestMultiError, or nil if none found.
+func (m *AdminTokenListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTokenListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminTokenListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTokenListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminTokenListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTokenListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTokenListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTokenListRequestMultiError) AllErrors() []error { return m }
+
+// AdminTokenListRequestValidationError is the validation error returned by
+// AdminTokenListRequest.Validate if the designated constraints aren't met.
+type AdminTokenListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTokenListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTokenListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTokenListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTokenListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTokenListRequestValidationError) ErrorName() string {
+	return "AdminTokenListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTokenListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTokenListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTokenListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTokenListRequestValidationError{}
+
+// Validate checks the field values on AdminTokenListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTokenListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTokenListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTokenListReplyMultiError, or nil if none found.
+func (m *AdminTokenListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTokenListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminTokenListReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminTokenListReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminTokenListReplyValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminTokenListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTokenListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminTokenListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTokenListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTokenListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTokenListReplyMultiError) AllErrors() []error { return m }
+
+// AdminTokenListReplyValidationError is the validation error returned by
+// AdminTokenListReply.Validate if the designated constraints aren't met.
+type AdminTokenListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTokenListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTokenListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTokenListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTokenListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTokenListReplyValidationError) ErrorName() string {
+	return "AdminTokenListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTokenListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTokenListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTokenListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTokenListReplyValidationError{}
+
+// Validate checks the field values on AdminTokenSaveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTokenSaveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTokenSaveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTokenSaveRequestMultiError, or nil if none found.
+func (m *AdminTokenSaveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTokenSaveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminTokenSaveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminTokenSaveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminTokenSaveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminTokenSaveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTokenSaveRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminTokenSaveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTokenSaveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTokenSaveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTokenSaveRequestMultiError) AllErrors() []error { return m }
+
+// AdminTokenSaveRequestValidationError is the validation error returned by
+// AdminTokenSaveRequest.Validate if the designated constraints aren't met.
+type AdminTokenSaveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTokenSaveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTokenSaveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTokenSaveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTokenSaveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTokenSaveRequestValidationError) ErrorName() string {
+	return "AdminTokenSaveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTokenSaveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTokenSaveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTokenSaveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTokenSaveRequestValidationError{}
+
+// Validate checks the field values on AdminTokenSaveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTokenSaveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTokenSaveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTokenSaveReplyMultiError, or nil if none found.
+func (m *AdminTokenSaveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTokenSaveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminTokenSaveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTokenSaveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminTokenSaveReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTokenSaveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTokenSaveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTokenSaveReplyMultiError) AllErrors() []error { return m }
+
+// AdminTokenSaveReplyValidationError is the validation error returned by
+// AdminTokenSaveReply.Validate if the designated constraints aren't met.
+type AdminTokenSaveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTokenSaveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTokenSaveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTokenSaveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTokenSaveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTokenSaveReplyValidationError) ErrorName() string {
+	return "AdminTokenSaveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTokenSaveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTokenSaveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTokenSaveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTokenSaveReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthReplyValidationError{}
+
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeRequestValidationError{}
+
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeReplyValidationError{}
+
+// Validate checks the field values on AdminDailyFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyFeeRequestMultiError, or nil if none found.
+func (m *AdminDailyFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	if len(errors) > 0 {
+		return AdminDailyFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyFeeRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDailyFeeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminDailyFeeRequestValidationError is the validation error returned by
+// AdminDailyFeeRequest.Validate if the designated constraints aren't met.
+type AdminDailyFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyFeeRequestValidationError) ErrorName() string {
+	return "AdminDailyFeeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyFeeRequestValidationError{}
+
+// Validate checks the field values on AdminDailyFeeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyFeeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyFeeReplyMultiError, or nil if none found.
+func (m *AdminDailyFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDailyFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminDailyFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminDailyFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminDailyFeeReplyValidationError is the validation error returned by
+// AdminDailyFeeReply.Validate if the designated constraints aren't met.
+type AdminDailyFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyFeeReplyValidationError) ErrorName() string {
+	return "AdminDailyFeeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyFeeReplyValidationError{}
+
+// Validate checks the field values on AdminAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllRequestValidationError{}
+
+// Validate checks the field values on AdminAllReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAllReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TodayTotalUser
+
+	// no validation rules for LocationCount
+
+	// no validation rules for TotalUser
+
+	// no validation rules for AllBalance
+
+	// no validation rules for AllBalanceBtc
+
+	// no validation rules for TodayLocation
+
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayWithdraw
+
+	// no validation rules for AllWithdraw
+
+	// no validation rules for AllWithdrawBtc
+
+	// no validation rules for TodayWithdrawBtc
+
+	// no validation rules for AllReward
+
+	// no validation rules for AllSystemRewardAndFee
+
+	// no validation rules for BalanceReward
+
+	// no validation rules for BalanceRewardRewarded
+
+	if len(errors) > 0 {
+		return AdminAllReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllReplyValidationError{}
+
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminUserRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminUserRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
+type AdminMonthRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMonthRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRequestValidationError{}
+
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfig() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminConfigReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminConfigReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigReplyValidationError{}
+
+// Validate checks the field values on AdminListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListRequestMultiError, or nil if none found.
+func (m *AdminListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListRequestMultiError) AllErrors() []error { return m }
+
+// AdminListRequestValidationError is the validation error returned by
+// AdminListRequest.Validate if the designated constraints aren't met.
+type AdminListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListRequestValidationError) ErrorName() string { return "AdminListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListRequestValidationError{}
+
+// Validate checks the field values on AdminListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminListReplyMultiError,
+// or nil if none found.
+func (m *AdminListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccount() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminListReplyValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminListReplyValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminListReplyValidationError{
+					field:  fmt.Sprintf("Account[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListReplyMultiError) AllErrors() []error { return m }
+
+// AdminListReplyValidationError is the validation error returned by
+// AdminListReply.Validate if the designated constraints aren't met.
+type AdminListReplyValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+func (e AdminListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e AdminListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e AdminListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+func (e AdminListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawReplyValidationError) ErrorName() string {
-	return "AdminWithdrawReplyValidationError"
+func (e AdminListReplyValidationError) ErrorName() string { return "AdminListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListReplyValidationError{}
+
+// Validate checks the field values on AuthListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthListRequestMultiError, or nil if none found.
+func (m *AuthListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthListRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthListRequestMultiError) AllErrors() []error { return m }
+
+// AuthListRequestValidationError is the validation error returned by
+// AuthListRequest.Validate if the designated constraints aren't met.
+type AuthListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthListRequestValidationError) ErrorName() string { return "AuthListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthListRequestValidationError{}
+
+// Validate checks the field values on AuthListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthListReplyMultiError, or
+// nil if none found.
+func (m *AuthListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by AuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthListReplyMultiError) AllErrors() []error { return m }
+
+// AuthListReplyValidationError is the validation error returned by
+// AuthListReply.Validate if the designated constraints aren't met.
+type AuthListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
 }
 
+// Field function returns field value.
+func (e AuthListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthListReplyValidationError) ErrorName() string { return "AuthListReplyValidationError" }
+
 // Error satisfies the builtin error interface
-func (e AdminWithdrawReplyValidationError) Error() string {
+func (e AuthListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4182,14 +11194,14 @@ func (e AdminWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawReply.%s: %s%s",
+		"invalid %sAuthListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawReplyValidationError{}
+var _ error = AuthListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4197,73 +11209,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawReplyValidationError{}
+} = AuthListReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawPassRequest with the rules
+// Validate checks the field values on UserAuthListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawPassRequest) Validate() error {
+func (m *UserAuthListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawPassRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserAuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawPassRequestMultiError, or nil if none found.
-func (m *AdminWithdrawPassRequest) ValidateAll() error {
+// UserAuthListRequestMultiError, or nil if none found.
+func (m *UserAuthListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawPassRequest) validate(all bool) error {
+func (m *UserAuthListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminWithdrawPassRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminWithdrawPassRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminWithdrawPassRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AdminId
 
 	if len(errors) > 0 {
-		return AdminWithdrawPassRequestMultiError(errors)
+		return UserAuthListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawPassRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawPassRequest.ValidateAll() if the designated
+// UserAuthListRequestMultiError is an error wrapping multiple validation
+// errors returned by UserAuthListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawPassRequestMultiError []error
+type UserAuthListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawPassRequestMultiError) Error() string {
+func (m UserAuthListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4272,11 +11257,11 @@ func (m AdminWithdrawPassRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawPassRequestMultiError) AllErrors() []error { return m }
+func (m UserAuthListRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawPassRequestValidationError is the validation error returned by
-// AdminWithdrawPassRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawPassRequestValidationError struct {
+// UserAuthListRequestValidationError is the validation error returned by
+// UserAuthListRequest.Validate if the designated constraints aren't met.
+type UserAuthListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4284,24 +11269,24 @@ type AdminWithdrawPassRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawPassRequestValidationError) Field() string { return e.field }
+func (e UserAuthListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawPassRequestValidationError) Reason() string { return e.reason }
+func (e UserAuthListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawPassRequestValidationError) Cause() error { return e.cause }
+func (e UserAuthListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawPassRequestValidationError) Key() bool { return e.key }
+func (e UserAuthListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawPassRequestValidationError) ErrorName() string {
-	return "AdminWithdrawPassRequestValidationError"
+func (e UserAuthListRequestValidationError) ErrorName() string {
+	return "UserAuthListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawPassRequestValidationError) Error() string {
+func (e UserAuthListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4313,14 +11298,14 @@ func (e AdminWithdrawPassRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawPassRequest.%s: %s%s",
+		"invalid %sUserAuthListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawPassRequestValidationError{}
+var _ error = UserAuthListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4328,44 +11313,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawPassRequestValidationError{}
+} = UserAuthListRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawPassReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawPassReply) Validate() error {
+// Validate checks the field values on UserAuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserAuthListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawPassReply with the rules
+// ValidateAll checks the field values on UserAuthListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawPassReplyMultiError, or nil if none found.
-func (m *AdminWithdrawPassReply) ValidateAll() error {
+// UserAuthListReplyMultiError, or nil if none found.
+func (m *UserAuthListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawPassReply) validate(all bool) error {
+func (m *UserAuthListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserAuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AdminWithdrawPassReplyMultiError(errors)
+		return UserAuthListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawPassReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawPassReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawPassReplyMultiError []error
+// UserAuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by UserAuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserAuthListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawPassReplyMultiError) Error() string {
+func (m UserAuthListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4374,11 +11393,11 @@ func (m AdminWithdrawPassReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawPassReplyMultiError) AllErrors() []error { return m }
+func (m UserAuthListReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawPassReplyValidationError is the validation error returned by
-// AdminWithdrawPassReply.Validate if the designated constraints aren't met.
-type AdminWithdrawPassReplyValidationError struct {
+// UserAuthListReplyValidationError is the validation error returned by
+// UserAuthListReply.Validate if the designated constraints aren't met.
+type UserAuthListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4386,24 +11405,24 @@ type AdminWithdrawPassReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawPassReplyValidationError) Field() string { return e.field }
+func (e UserAuthListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawPassReplyValidationError) Reason() string { return e.reason }
+func (e UserAuthListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawPassReplyValidationError) Cause() error { return e.cause }
+func (e UserAuthListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawPassReplyValidationError) Key() bool { return e.key }
+func (e UserAuthListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawPassReplyValidationError) ErrorName() string {
-	return "AdminWithdrawPassReplyValidationError"
+func (e UserAuthListReplyValidationError) ErrorName() string {
+	return "UserAuthListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawPassReplyValidationError) Error() string {
+func (e UserAuthListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4415,14 +11434,14 @@ func (e AdminWithdrawPassReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawPassReply.%s: %s%s",
+		"invalid %sUserAuthListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawPassReplyValidationError{}
+var _ error = UserAuthListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4430,24 +11449,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawPassReplyValidationError{}
+} = UserAuthListReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+// Validate checks the field values on MyAuthListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MyAuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// MyAuthListRequestMultiError, or nil if none found.
+func (m *MyAuthListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
+func (m *MyAuthListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4455,19 +11474,19 @@ func (m *AdminWithdrawEthRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+		return MyAuthListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+// MyAuthListRequestMultiError is an error wrapping multiple validation errors
+// returned by MyAuthListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MyAuthListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m MyAuthListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4476,11 +11495,11 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m MyAuthListRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// MyAuthListRequestValidationError is the validation error returned by
+// MyAuthListRequest.Validate if the designated constraints aren't met.
+type MyAuthListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4488,24 +11507,24 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e MyAuthListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e MyAuthListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e MyAuthListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e MyAuthListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
+func (e MyAuthListRequestValidationError) ErrorName() string {
+	return "MyAuthListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e MyAuthListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4517,14 +11536,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sMyAuthListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = MyAuthListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4532,44 +11551,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = MyAuthListRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+// Validate checks the field values on MyAuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// ValidateAll checks the field values on MyAuthListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// MyAuthListReplyMultiError, or nil if none found.
+func (m *MyAuthListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *MyAuthListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MyAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MyAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MyAuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Super
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return MyAuthListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+// MyAuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by MyAuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type MyAuthListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m MyAuthListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4578,11 +11633,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m MyAuthListReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// MyAuthListReplyValidationError is the validation error returned by
+// MyAuthListReply.Validate if the designated constraints aren't met.
+type MyAuthListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4590,24 +11645,22 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e MyAuthListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e MyAuthListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e MyAuthListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e MyAuthListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
-}
+func (e MyAuthListReplyValidationError) ErrorName() string { return "MyAuthListReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e MyAuthListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4619,14 +11672,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sMyAuthListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = MyAuthListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4634,44 +11687,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = MyAuthListReplyValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return AdminConfigUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m AdminConfigUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4680,11 +11762,11 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4692,22 +11774,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e AdminConfigUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4719,14 +11803,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = AdminConfigUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4734,24 +11818,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = AdminConfigUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *AdminConfigUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4759,19 +11843,19 @@ func (m *AdminFeeReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return AdminConfigUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m AdminConfigUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4780,11 +11864,11 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4792,22 +11876,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e AdminConfigUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4819,14 +11905,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = AdminConfigUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4834,46 +11920,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = AdminConfigUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminDailyFeeRequest with the rules
+// Validate checks the field values on AdminVipUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyFeeRequest) Validate() error {
+func (m *AdminVipUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyFeeRequest with the rules
+// ValidateAll checks the field values on AdminVipUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminDailyFeeRequestMultiError, or nil if none found.
-func (m *AdminDailyFeeRequest) ValidateAll() error {
+// AdminVipUpdateRequestMultiError, or nil if none found.
+func (m *AdminVipUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyFeeRequest) validate(all bool) error {
+func (m *AdminVipUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Day
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminVipUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminVipUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminVipUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminDailyFeeRequestMultiError(errors)
+		return AdminVipUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyFeeRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminDailyFeeRequest.ValidateAll() if the designated
+// AdminVipUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipUpdateRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminDailyFeeRequestMultiError []error
+type AdminVipUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyFeeRequestMultiError) Error() string {
+func (m AdminVipUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4882,11 +11995,11 @@ func (m AdminDailyFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyFeeRequestMultiError) AllErrors() []error { return m }
+func (m AdminVipUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminDailyFeeRequestValidationError is the validation error returned by
-// AdminDailyFeeRequest.Validate if the designated constraints aren't met.
-type AdminDailyFeeRequestValidationError struct {
+// AdminVipUpdateRequestValidationError is the validation error returned by
+// AdminVipUpdateRequest.Validate if the designated constraints aren't met.
+type AdminVipUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4894,24 +12007,24 @@ type AdminDailyFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyFeeRequestValidationError) Field() string { return e.field }
+func (e AdminVipUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyFeeRequestValidationError) Reason() string { return e.reason }
+func (e AdminVipUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyFeeRequestValidationError) Cause() error { return e.cause }
+func (e AdminVipUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyFeeRequestValidationError) Key() bool { return e.key }
+func (e AdminVipUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyFeeRequestValidationError) ErrorName() string {
-	return "AdminDailyFeeRequestValidationError"
+func (e AdminVipUpdateRequestValidationError) ErrorName() string {
+	return "AdminVipUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyFeeRequestValidationError) Error() string {
+func (e AdminVipUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4923,14 +12036,14 @@ func (e AdminDailyFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyFeeRequest.%s: %s%s",
+		"invalid %sAdminVipUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyFeeRequestValidationError{}
+var _ error = AdminVipUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4938,24 +12051,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyFeeRequestValidationError{}
+} = AdminVipUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminDailyFeeReply with the rules
+// Validate checks the field values on AdminVipUpdateReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyFeeReply) Validate() error {
+func (m *AdminVipUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyFeeReply with the rules
+// ValidateAll checks the field values on AdminVipUpdateReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminDailyFeeReplyMultiError, or nil if none found.
-func (m *AdminDailyFeeReply) ValidateAll() error {
+// AdminVipUpdateReplyMultiError, or nil if none found.
+func (m *AdminVipUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyFeeReply) validate(all bool) error {
+func (m *AdminVipUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4963,19 +12076,19 @@ func (m *AdminDailyFeeReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminDailyFeeReplyMultiError(errors)
+		return AdminVipUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminDailyFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminDailyFeeReplyMultiError []error
+// AdminVipUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyFeeReplyMultiError) Error() string {
+func (m AdminVipUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4984,11 +12097,11 @@ func (m AdminDailyFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyFeeReplyMultiError) AllErrors() []error { return m }
+func (m AdminVipUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminDailyFeeReplyValidationError is the validation error returned by
-// AdminDailyFeeReply.Validate if the designated constraints aren't met.
-type AdminDailyFeeReplyValidationError struct {
+// AdminVipUpdateReplyValidationError is the validation error returned by
+// AdminVipUpdateReply.Validate if the designated constraints aren't met.
+type AdminVipUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4996,24 +12109,24 @@ type AdminDailyFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyFeeReplyValidationError) Field() string { return e.field }
+func (e AdminVipUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyFeeReplyValidationError) Reason() string { return e.reason }
+func (e AdminVipUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyFeeReplyValidationError) Cause() error { return e.cause }
+func (e AdminVipUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyFeeReplyValidationError) Key() bool { return e.key }
+func (e AdminVipUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyFeeReplyValidationError) ErrorName() string {
-	return "AdminDailyFeeReplyValidationError"
+func (e AdminVipUpdateReplyValidationError) ErrorName() string {
+	return "AdminVipUpdateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyFeeReplyValidationError) Error() string {
+func (e AdminVipUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5025,14 +12138,14 @@ func (e AdminDailyFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyFeeReply.%s: %s%s",
+		"invalid %sAdminVipUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyFeeReplyValidationError{}
+var _ error = AdminVipUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5040,44 +12153,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyFeeReplyValidationError{}
+} = AdminVipUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on AdminUndoUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUndoUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
+// ValidateAll checks the field values on AdminUndoUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// AdminUndoUpdateRequestMultiError, or nil if none found.
+func (m *AdminUndoUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *AdminUndoUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUndoUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUndoUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUndoUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return AdminUndoUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// AdminUndoUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUndoUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUndoUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m AdminUndoUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5086,11 +12228,11 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m AdminUndoUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// AdminUndoUpdateRequestValidationError is the validation error returned by
+// AdminUndoUpdateRequest.Validate if the designated constraints aren't met.
+type AdminUndoUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5098,22 +12240,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e AdminUndoUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e AdminUndoUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e AdminUndoUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e AdminUndoUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e AdminUndoUpdateRequestValidationError) ErrorName() string {
+	return "AdminUndoUpdateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e AdminUndoUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5125,14 +12269,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sAdminUndoUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = AdminUndoUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5140,74 +12284,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = AdminUndoUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on AdminUndoUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUndoUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminUndoUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUndoUpdateReplyMultiError, or nil if none found.
+func (m *AdminUndoUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *AdminUndoUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TodayTotalUser
-
-	// no validation rules for LocationCount
-
-	// no validation rules for TotalUser
-
-	// no validation rules for AllBalance
-
-	// no validation rules for AllBalanceBtc
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayWithdraw
-
-	// no validation rules for AllWithdraw
-
-	// no validation rules for AllWithdrawBtc
-
-	// no validation rules for TodayWithdrawBtc
-
-	// no validation rules for AllReward
-
-	// no validation rules for AllSystemRewardAndFee
-
-	// no validation rules for BalanceReward
-
-	// no validation rules for BalanceRewardRewarded
-
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return AdminUndoUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// AdminUndoUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUndoUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUndoUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m AdminUndoUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5216,11 +12330,11 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
-
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+func (m AdminUndoUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminUndoUpdateReplyValidationError is the validation error returned by
+// AdminUndoUpdateReply.Validate if the designated constraints aren't met.
+type AdminUndoUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5228,22 +12342,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e AdminUndoUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e AdminUndoUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e AdminUndoUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e AdminUndoUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e AdminUndoUpdateReplyValidationError) ErrorName() string {
+	return "AdminUndoUpdateReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e AdminUndoUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5255,14 +12371,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sAdminUndoUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = AdminUndoUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5270,48 +12386,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = AdminUndoUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminAreaLevelUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *AdminAreaLevelUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// ValidateAll checks the field values on AdminAreaLevelUpdateRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// AdminAreaLevelUpdateRequestMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAreaLevelUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return AdminAreaLevelUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// AdminAreaLevelUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminAreaLevelUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminAreaLevelUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5320,11 +12461,12 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m AdminAreaLevelUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// AdminAreaLevelUpdateRequestValidationError is the validation error returned
+// by AdminAreaLevelUpdateRequest.Validate if the designated constraints
+// aren't met.
+type AdminAreaLevelUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5332,24 +12474,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e AdminAreaLevelUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e AdminAreaLevelUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e AdminAreaLevelUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e AdminAreaLevelUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e AdminAreaLevelUpdateRequestValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5361,14 +12503,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sAdminAreaLevelUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = AdminAreaLevelUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5376,78 +12518,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = AdminAreaLevelUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
+// Validate checks the field values on AdminAreaLevelUpdateReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+func (m *AdminAreaLevelUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
+// ValidateAll checks the field values on AdminAreaLevelUpdateReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// AdminAreaLevelUpdateReplyMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *AdminAreaLevelUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return AdminAreaLevelUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+// AdminAreaLevelUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminAreaLevelUpdateReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminAreaLevelUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5456,11 +12564,11 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AdminAreaLevelUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// AdminAreaLevelUpdateReplyValidationError is the validation error returned by
+// AdminAreaLevelUpdateReply.Validate if the designated constraints aren't met.
+type AdminAreaLevelUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5468,24 +12576,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e AdminAreaLevelUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AdminAreaLevelUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AdminAreaLevelUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e AdminAreaLevelUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e AdminAreaLevelUpdateReplyValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5497,14 +12605,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sAdminAreaLevelUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = AdminAreaLevelUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5512,48 +12620,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = AdminAreaLevelUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
+// Validate checks the field values on AdminLocationInsertRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+func (m *AdminLocationInsertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// ValidateAll checks the field values on AdminLocationInsertRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// AdminLocationInsertRequestMultiError, or nil if none found.
+func (m *AdminLocationInsertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *AdminLocationInsertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Page
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLocationInsertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLocationInsertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLocationInsertRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return AdminLocationInsertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// AdminLocationInsertRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationInsertRequest.ValidateAll() if
 // the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+type AdminLocationInsertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m AdminLocationInsertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5562,11 +12695,11 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
-
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+func (m AdminLocationInsertRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationInsertRequestValidationError is the validation error returned
+// by AdminLocationInsertRequest.Validate if the designated constraints aren't met.
+type AdminLocationInsertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5574,24 +12707,24 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e AdminLocationInsertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationInsertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationInsertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationInsertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
+func (e AdminLocationInsertRequestValidationError) ErrorName() string {
+	return "AdminLocationInsertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e AdminLocationInsertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5603,14 +12736,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sAdminLocationInsertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = AdminLocationInsertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5618,80 +12751,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = AdminLocationInsertRequestValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
+// Validate checks the field values on AdminLocationInsertReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+func (m *AdminLocationInsertReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// ValidateAll checks the field values on AdminLocationInsertReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// AdminLocationInsertReplyMultiError, or nil if none found.
+func (m *AdminLocationInsertReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *AdminLocationInsertReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return AdminLocationInsertReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// AdminLocationInsertReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationInsertReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+type AdminLocationInsertReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m AdminLocationInsertReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5700,11 +12797,11 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationInsertReplyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// AdminLocationInsertReplyValidationError is the validation error returned by
+// AdminLocationInsertReply.Validate if the designated constraints aren't met.
+type AdminLocationInsertReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5712,24 +12809,24 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e AdminLocationInsertReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationInsertReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationInsertReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationInsertReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
+func (e AdminLocationInsertReplyValidationError) ErrorName() string {
+	return "AdminLocationInsertReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e AdminLocationInsertReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5741,14 +12838,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sAdminLocationInsertReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = AdminLocationInsertReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5756,48 +12853,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = AdminLocationInsertReplyValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
+// Validate checks the field values on AdminBalanceUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+func (m *AdminBalanceUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminBalanceUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// AdminBalanceUpdateRequestMultiError, or nil if none found.
+func (m *AdminBalanceUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *AdminBalanceUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Page
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminBalanceUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminBalanceUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminBalanceUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return AdminBalanceUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// AdminBalanceUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceUpdateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminBalanceUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m AdminBalanceUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5806,11 +12928,11 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m AdminBalanceUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// AdminBalanceUpdateRequestValidationError is the validation error returned by
+// AdminBalanceUpdateRequest.Validate if the designated constraints aren't met.
+type AdminBalanceUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5818,24 +12940,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e AdminBalanceUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e AdminBalanceUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e AdminBalanceUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e AdminBalanceUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e AdminBalanceUpdateRequestValidationError) ErrorName() string {
+	return "AdminBalanceUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e AdminBalanceUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5847,14 +12969,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sAdminBalanceUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = AdminBalanceUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5862,80 +12984,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = AdminBalanceUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on AdminBalanceUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminBalanceUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// AdminBalanceUpdateReplyMultiError, or nil if none found.
+func (m *AdminBalanceUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *AdminBalanceUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
+		return AdminBalanceUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigReplyMultiError []error
+// AdminBalanceUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m AdminBalanceUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5944,11 +13030,11 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m AdminBalanceUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// AdminBalanceUpdateReplyValidationError is the validation error returned by
+// AdminBalanceUpdateReply.Validate if the designated constraints aren't met.
+type AdminBalanceUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5956,22 +13042,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e AdminBalanceUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e AdminBalanceUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e AdminBalanceUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e AdminBalanceUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e AdminBalanceUpdateReplyValidationError) ErrorName() string {
+	return "AdminBalanceUpdateReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e AdminBalanceUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5983,14 +13071,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sAdminBalanceUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = AdminBalanceUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5998,44 +13086,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = AdminBalanceUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminListRequest) Validate() error {
+// Validate checks the field values on AuthAdminCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListRequest with the rules
+// ValidateAll checks the field values on AuthAdminCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminListRequestMultiError, or nil if none found.
-func (m *AdminListRequest) ValidateAll() error {
+// AuthAdminCreateRequestMultiError, or nil if none found.
+func (m *AuthAdminCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListRequest) validate(all bool) error {
+func (m *AuthAdminCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthAdminCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthAdminCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthAdminCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminListRequestMultiError(errors)
+		return AuthAdminCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminListRequestMultiError []error
+// AuthAdminCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListRequestMultiError) Error() string {
+func (m AuthAdminCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6044,11 +13161,11 @@ func (m AdminListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListRequestMultiError) AllErrors() []error { return m }
+func (m AuthAdminCreateRequestMultiError) AllErrors() []error { return m }
 
-// AdminListRequestValidationError is the validation error returned by
-// AdminListRequest.Validate if the designated constraints aren't met.
-type AdminListRequestValidationError struct {
+// AuthAdminCreateRequestValidationError is the validation error returned by
+// AuthAdminCreateRequest.Validate if the designated constraints aren't met.
+type AuthAdminCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6056,22 +13173,24 @@ type AdminListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListRequestValidationError) Field() string { return e.field }
+func (e AuthAdminCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListRequestValidationError) Reason() string { return e.reason }
+func (e AuthAdminCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListRequestValidationError) Cause() error { return e.cause }
+func (e AuthAdminCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListRequestValidationError) Key() bool { return e.key }
+func (e AuthAdminCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListRequestValidationError) ErrorName() string { return "AdminListRequestValidationError" }
+func (e AuthAdminCreateRequestValidationError) ErrorName() string {
+	return "AuthAdminCreateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminListRequestValidationError) Error() string {
+func (e AuthAdminCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6083,14 +13202,14 @@ func (e AdminListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListRequest.%s: %s%s",
+		"invalid %sAuthAdminCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListRequestValidationError{}
+var _ error = AuthAdminCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6098,78 +13217,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListRequestValidationError{}
+} = AuthAdminCreateRequestValidationError{}
 
-// Validate checks the field values on AdminListReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminListReply) Validate() error {
+// Validate checks the field values on AuthAdminCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminCreateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminListReplyMultiError,
-// or nil if none found.
-func (m *AdminListReply) ValidateAll() error {
+// ValidateAll checks the field values on AuthAdminCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminCreateReplyMultiError, or nil if none found.
+func (m *AuthAdminCreateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListReply) validate(all bool) error {
+func (m *AuthAdminCreateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAccount() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminListReplyValidationError{
-						field:  fmt.Sprintf("Account[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminListReplyValidationError{
-						field:  fmt.Sprintf("Account[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminListReplyValidationError{
-					field:  fmt.Sprintf("Account[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AdminListReplyMultiError(errors)
+		return AuthAdminCreateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminListReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminListReplyMultiError []error
+// AuthAdminCreateReplyMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminCreateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminCreateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListReplyMultiError) Error() string {
+func (m AuthAdminCreateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6178,11 +13263,11 @@ func (m AdminListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListReplyMultiError) AllErrors() []error { return m }
+func (m AuthAdminCreateReplyMultiError) AllErrors() []error { return m }
 
-// AdminListReplyValidationError is the validation error returned by
-// AdminListReply.Validate if the designated constraints aren't met.
-type AdminListReplyValidationError struct {
+// AuthAdminCreateReplyValidationError is the validation error returned by
+// AuthAdminCreateReply.Validate if the designated constraints aren't met.
+type AuthAdminCreateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6190,22 +13275,24 @@ type AdminListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListReplyValidationError) Field() string { return e.field }
+func (e AuthAdminCreateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListReplyValidationError) Reason() string { return e.reason }
+func (e AuthAdminCreateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListReplyValidationError) Cause() error { return e.cause }
+func (e AuthAdminCreateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListReplyValidationError) Key() bool { return e.key }
+func (e AuthAdminCreateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListReplyValidationError) ErrorName() string { return "AdminListReplyValidationError" }
+func (e AuthAdminCreateReplyValidationError) ErrorName() string {
+	return "AuthAdminCreateReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminListReplyValidationError) Error() string {
+func (e AuthAdminCreateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6217,14 +13304,14 @@ func (e AdminListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListReply.%s: %s%s",
+		"invalid %sAuthAdminCreateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListReplyValidationError{}
+var _ error = AuthAdminCreateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6232,44 +13319,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListReplyValidationError{}
+} = AuthAdminCreateReplyValidationError{}
 
-// Validate checks the field values on AuthListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthListRequest) Validate() error {
+// Validate checks the field values on AuthAdminDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListRequest with the rules
+// ValidateAll checks the field values on AuthAdminDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthListRequestMultiError, or nil if none found.
-func (m *AuthListRequest) ValidateAll() error {
+// AuthAdminDeleteRequestMultiError, or nil if none found.
+func (m *AuthAdminDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListRequest) validate(all bool) error {
+func (m *AuthAdminDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthAdminDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthAdminDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthAdminDeleteRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AuthListRequestMultiError(errors)
+		return AuthAdminDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListRequestMultiError []error
+// AuthAdminDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListRequestMultiError) Error() string {
+func (m AuthAdminDeleteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6278,11 +13394,11 @@ func (m AuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListRequestMultiError) AllErrors() []error { return m }
+func (m AuthAdminDeleteRequestMultiError) AllErrors() []error { return m }
 
-// AuthListRequestValidationError is the validation error returned by
-// AuthListRequest.Validate if the designated constraints aren't met.
-type AuthListRequestValidationError struct {
+// AuthAdminDeleteRequestValidationError is the validation error returned by
+// AuthAdminDeleteRequest.Validate if the designated constraints aren't met.
+type AuthAdminDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6290,22 +13406,24 @@ type AuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListRequestValidationError) Field() string { return e.field }
+func (e AuthAdminDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListRequestValidationError) Reason() string { return e.reason }
+func (e AuthAdminDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListRequestValidationError) Cause() error { return e.cause }
+func (e AuthAdminDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListRequestValidationError) Key() bool { return e.key }
+func (e AuthAdminDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListRequestValidationError) ErrorName() string { return "AuthListRequestValidationError" }
+func (e AuthAdminDeleteRequestValidationError) ErrorName() string {
+	return "AuthAdminDeleteRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthListRequestValidationError) Error() string {
+func (e AuthAdminDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6317,14 +13435,14 @@ func (e AuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListRequest.%s: %s%s",
+		"invalid %sAuthAdminDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListRequestValidationError{}
+var _ error = AuthAdminDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6332,78 +13450,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListRequestValidationError{}
+} = AuthAdminDeleteRequestValidationError{}
 
-// Validate checks the field values on AuthListReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthListReply) Validate() error {
+// Validate checks the field values on AuthAdminDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminDeleteReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthListReplyMultiError, or
-// nil if none found.
-func (m *AuthListReply) ValidateAll() error {
+// ValidateAll checks the field values on AuthAdminDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminDeleteReplyMultiError, or nil if none found.
+func (m *AuthAdminDeleteReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListReply) validate(all bool) error {
+func (m *AuthAdminDeleteReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAuth() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AuthListReplyMultiError(errors)
+		return AuthAdminDeleteReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by AuthListReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListReplyMultiError []error
+// AuthAdminDeleteReplyMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminDeleteReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminDeleteReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListReplyMultiError) Error() string {
+func (m AuthAdminDeleteReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6412,11 +13496,11 @@ func (m AuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListReplyMultiError) AllErrors() []error { return m }
+func (m AuthAdminDeleteReplyMultiError) AllErrors() []error { return m }
 
-// AuthListReplyValidationError is the validation error returned by
-// AuthListReply.Validate if the designated constraints aren't met.
-type AuthListReplyValidationError struct {
+// AuthAdminDeleteReplyValidationError is the validation error returned by
+// AuthAdminDeleteReply.Validate if the designated constraints aren't met.
+type AuthAdminDeleteReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6424,22 +13508,24 @@ type AuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListReplyValidationError) Field() string { return e.field }
+func (e AuthAdminDeleteReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListReplyValidationError) Reason() string { return e.reason }
+func (e AuthAdminDeleteReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListReplyValidationError) Cause() error { return e.cause }
+func (e AuthAdminDeleteReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListReplyValidationError) Key() bool { return e.key }
+func (e AuthAdminDeleteReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListReplyValidationError) ErrorName() string { return "AuthListReplyValidationError" }
+func (e AuthAdminDeleteReplyValidationError) ErrorName() string {
+	return "AuthAdminDeleteReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthListReplyValidationError) Error() string {
+func (e AuthAdminDeleteReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6451,14 +13537,14 @@ func (e AuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListReply.%s: %s%s",
+		"invalid %sAuthAdminDeleteReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListReplyValidationError{}
+var _ error = AuthAdminDeleteReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6466,46 +13552,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListReplyValidationError{}
+} = AuthAdminDeleteReplyValidationError{}
 
-// Validate checks the field values on UserAuthListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListRequest) Validate() error {
+// Validate checks the field values on CheckAndInsertRecommendAreaRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckAndInsertRecommendAreaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserAuthListRequestMultiError, or nil if none found.
-func (m *UserAuthListRequest) ValidateAll() error {
+// ValidateAll checks the field values on CheckAndInsertRecommendAreaRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckAndInsertRecommendAreaRequestMultiError, or nil if none found.
+func (m *CheckAndInsertRecommendAreaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListRequest) validate(all bool) error {
+func (m *CheckAndInsertRecommendAreaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AdminId
-
 	if len(errors) > 0 {
-		return UserAuthListRequestMultiError(errors)
+		return CheckAndInsertRecommendAreaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListRequestMultiError is an error wrapping multiple validation
-// errors returned by UserAuthListRequest.ValidateAll() if the designated
+// CheckAndInsertRecommendAreaRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckAndInsertRecommendAreaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UserAuthListRequestMultiError []error
+type CheckAndInsertRecommendAreaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListRequestMultiError) Error() string {
+func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6514,11 +13600,12 @@ func (m UserAuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListRequestMultiError) AllErrors() []error { return m }
+func (m CheckAndInsertRecommendAreaRequestMultiError) AllErrors() []error { return m }
 
-// UserAuthListRequestValidationError is the validation error returned by
-// UserAuthListRequest.Validate if the designated constraints aren't met.
-type UserAuthListRequestValidationError struct {
+// CheckAndInsertRecommendAreaRequestValidationError is the validation error
+// returned by CheckAndInsertRecommendAreaRequest.Validate if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6526,24 +13613,24 @@ type UserAuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListRequestValidationError) Field() string { return e.field }
+func (e CheckAndInsertRecommendAreaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListRequestValidationError) Reason() string { return e.reason }
+func (e CheckAndInsertRecommendAreaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListRequestValidationError) Cause() error { return e.cause }
+func (e CheckAndInsertRecommendAreaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListRequestValidationError) Key() bool { return e.key }
+func (e CheckAndInsertRecommendAreaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListRequestValidationError) ErrorName() string {
-	return "UserAuthListRequestValidationError"
+func (e CheckAndInsertRecommendAreaRequestValidationError) ErrorName() string {
+	return "CheckAndInsertRecommendAreaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListRequestValidationError) Error() string {
+func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6555,14 +13642,14 @@ func (e UserAuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListRequest.%s: %s%s",
+		"invalid %sCheckAndInsertRecommendAreaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListRequestValidationError{}
+var _ error = CheckAndInsertRecommendAreaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6570,78 +13657,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListRequestValidationError{}
+} = CheckAndInsertRecommendAreaRequestValidationError{}
 
-// Validate checks the field values on UserAuthListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListReply) Validate() error {
+// Validate checks the field values on CheckAndInsertRecommendAreaReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckAndInsertRecommendAreaReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserAuthListReplyMultiError, or nil if none found.
-func (m *UserAuthListReply) ValidateAll() error {
+// ValidateAll checks the field values on CheckAndInsertRecommendAreaReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckAndInsertRecommendAreaReplyMultiError, or nil if none found.
+func (m *CheckAndInsertRecommendAreaReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListReply) validate(all bool) error {
+func (m *CheckAndInsertRecommendAreaReply) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetAuth() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserAuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return UserAuthListReplyMultiError(errors)
+		return CheckAndInsertRecommendAreaReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by UserAuthListReply.ValidateAll() if the designated constraints
-// aren't met.
-type UserAuthListReplyMultiError []error
+// CheckAndInsertRecommendAreaReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckAndInsertRecommendAreaReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListReplyMultiError) Error() string {
+func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6650,11 +13705,12 @@ func (m UserAuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListReplyMultiError) AllErrors() []error { return m }
+func (m CheckAndInsertRecommendAreaReplyMultiError) AllErrors() []error { return m }
 
-// UserAuthListReplyValidationError is the validation error returned by
-// UserAuthListReply.Validate if the designated constraints aren't met.
-type UserAuthListReplyValidationError struct {
+// CheckAndInsertRecommendAreaReplyValidationError is the validation error
+// returned by CheckAndInsertRecommendAreaReply.Validate if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6662,24 +13718,24 @@ type UserAuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListReplyValidationError) Field() string { return e.field }
+func (e CheckAndInsertRecommendAreaReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListReplyValidationError) Reason() string { return e.reason }
+func (e CheckAndInsertRecommendAreaReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListReplyValidationError) Cause() error { return e.cause }
+func (e CheckAndInsertRecommendAreaReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListReplyValidationError) Key() bool { return e.key }
+func (e CheckAndInsertRecommendAreaReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListReplyValidationError) ErrorName() string {
-	return "UserAuthListReplyValidationError"
+func (e CheckAndInsertRecommendAreaReplyValidationError) ErrorName() string {
+	return "CheckAndInsertRecommendAreaReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListReplyValidationError) Error() string {
+func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6691,14 +13747,14 @@ func (e UserAuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListReply.%s: %s%s",
+		"invalid %sCheckAndInsertRecommendAreaReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListReplyValidationError{}
+var _ error = CheckAndInsertRecommendAreaReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6706,44 +13762,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListReplyValidationError{}
+} = CheckAndInsertRecommendAreaReplyValidationError{}
 
-// Validate checks the field values on MyAuthListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListRequest) Validate() error {
+// Validate checks the field values on AdminDailyRecommendRewardRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDailyRecommendRewardRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MyAuthListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MyAuthListRequestMultiError, or nil if none found.
-func (m *MyAuthListRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyRecommendRewardRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyRecommendRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyRecommendRewardRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListRequest) validate(all bool) error {
+func (m *AdminDailyRecommendRewardRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Day
+
 	if len(errors) > 0 {
-		return MyAuthListRequestMultiError(errors)
+		return AdminDailyRecommendRewardRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListRequestMultiError is an error wrapping multiple validation errors
-// returned by MyAuthListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type MyAuthListRequestMultiError []error
+// AdminDailyRecommendRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDailyRecommendRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyRecommendRewardRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListRequestMultiError) Error() string {
+func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6752,11 +13812,12 @@ func (m MyAuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyRecommendRewardRequestMultiError) AllErrors() []error { return m }
 
-// MyAuthListRequestValidationError is the validation error returned by
-// MyAuthListRequest.Validate if the designated constraints aren't met.
-type MyAuthListRequestValidationError struct {
+// AdminDailyRecommendRewardRequestValidationError is the validation error
+// returned by AdminDailyRecommendRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyRecommendRewardRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6764,24 +13825,24 @@ type MyAuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListRequestValidationError) Field() string { return e.field }
+func (e AdminDailyRecommendRewardRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyRecommendRewardRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyRecommendRewardRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyRecommendRewardRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListRequestValidationError) ErrorName() string {
-	return "MyAuthListRequestValidationError"
+func (e AdminDailyRecommendRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyRecommendRewardRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MyAuthListRequestValidationError) Error() string {
+func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6793,14 +13854,14 @@ func (e MyAuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListRequest.%s: %s%s",
+		"invalid %sAdminDailyRecommendRewardRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListRequestValidationError{}
+var _ error = AdminDailyRecommendRewardRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6808,80 +13869,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListRequestValidationError{}
+} = AdminDailyRecommendRewardRequestValidationError{}
 
-// Validate checks the field values on MyAuthListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListReply) Validate() error {
+// Validate checks the field values on AdminDailyRecommendRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyRecommendRewardReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MyAuthListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MyAuthListReplyMultiError, or nil if none found.
-func (m *MyAuthListReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyRecommendRewardReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyRecommendRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyRecommendRewardReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListReply) validate(all bool) error {
+func (m *AdminDailyRecommendRewardReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAuth() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MyAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MyAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MyAuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Super
-
 	if len(errors) > 0 {
-		return MyAuthListReplyMultiError(errors)
+		return AdminDailyRecommendRewardReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by MyAuthListReply.ValidateAll() if the designated constraints
-// aren't met.
-type MyAuthListReplyMultiError []error
+// AdminDailyRecommendRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyRecommendRewardReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyRecommendRewardReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListReplyMultiError) Error() string {
+func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6890,11 +13915,12 @@ func (m MyAuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyRecommendRewardReplyMultiError) AllErrors() []error { return m }
 
-// MyAuthListReplyValidationError is the validation error returned by
-// MyAuthListReply.Validate if the designated constraints aren't met.
-type MyAuthListReplyValidationError struct {
+// AdminDailyRecommendRewardReplyValidationError is the validation error
+// returned by AdminDailyRecommendRewardReply.Validate if the designated
+// constraints aren't met.
+type AdminDailyRecommendRewardReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6902,22 +13928,24 @@ type MyAuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListReplyValidationError) Field() string { return e.field }
+func (e AdminDailyRecommendRewardReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyRecommendRewardReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyRecommendRewardReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyRecommendRewardReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListReplyValidationError) ErrorName() string { return "MyAuthListReplyValidationError" }
+func (e AdminDailyRecommendRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyRecommendRewardReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MyAuthListReplyValidationError) Error() string {
+func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6929,14 +13957,14 @@ func (e MyAuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListReply.%s: %s%s",
+		"invalid %sAdminDailyRecommendRewardReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListReplyValidationError{}
+var _ error = AdminDailyRecommendRewardReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6944,73 +13972,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListReplyValidationError{}
+} = AdminDailyRecommendRewardReplyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDailyBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *AdminDailyBalanceRewardRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyBalanceRewardRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyBalanceRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyBalanceRewardRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *AdminDailyBalanceRewardRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
+	var errors []error
+
+	// no validation rules for Date
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return AdminDailyBalanceRewardRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+// AdminDailyBalanceRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyBalanceRewardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyBalanceRewardRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7019,11 +14020,12 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyBalanceRewardRequestMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// AdminDailyBalanceRewardRequestValidationError is the validation error
+// returned by AdminDailyBalanceRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyBalanceRewardRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7031,24 +14033,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminDailyBalanceRewardRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyBalanceRewardRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyBalanceRewardRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyBalanceRewardRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e AdminDailyBalanceRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyBalanceRewardRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7060,14 +14062,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sAdminDailyBalanceRewardRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = AdminDailyBalanceRewardRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7075,24 +14077,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
+} = AdminDailyBalanceRewardRequestValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDailyBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+func (m *AdminDailyBalanceRewardReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDailyBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// AdminDailyBalanceRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyBalanceRewardReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *AdminDailyBalanceRewardReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7100,19 +14102,19 @@ func (m *AdminConfigUpdateReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return AdminDailyBalanceRewardReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+// AdminDailyBalanceRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyBalanceRewardReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDailyBalanceRewardReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7121,11 +14123,12 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyBalanceRewardReplyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// AdminDailyBalanceRewardReplyValidationError is the validation error returned
+// by AdminDailyBalanceRewardReply.Validate if the designated constraints
+// aren't met.
+type AdminDailyBalanceRewardReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7133,24 +14136,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminDailyBalanceRewardReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyBalanceRewardReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyBalanceRewardReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyBalanceRewardReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e AdminDailyBalanceRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyBalanceRewardReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7162,14 +14165,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sAdminDailyBalanceRewardReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = AdminDailyBalanceRewardReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7177,24 +14180,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = AdminDailyBalanceRewardReplyValidationError{}
 
-// Validate checks the field values on AdminVipUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateRequest) Validate() error {
+// Validate checks the field values on AdminLoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateRequest with the rules
+// ValidateAll checks the field values on AdminLoginRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateRequestMultiError, or nil if none found.
-func (m *AdminVipUpdateRequest) ValidateAll() error {
+// AdminLoginRequestMultiError, or nil if none found.
+func (m *AdminLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateRequest) validate(all bool) error {
+func (m *AdminLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7205,7 +14208,7 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminVipUpdateRequestValidationError{
+				errors = append(errors, AdminLoginRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7213,7 +14216,7 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminVipUpdateRequestValidationError{
+				errors = append(errors, AdminLoginRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7222,7 +14225,7 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminVipUpdateRequestValidationError{
+			return AdminLoginRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7231,19 +14234,19 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminVipUpdateRequestMultiError(errors)
+		return AdminLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminVipUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminVipUpdateRequestMultiError []error
+// AdminLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateRequestMultiError) Error() string {
+func (m AdminLoginRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7252,11 +14255,11 @@ func (m AdminVipUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateRequestValidationError is the validation error returned by
-// AdminVipUpdateRequest.Validate if the designated constraints aren't met.
-type AdminVipUpdateRequestValidationError struct {
+// AdminLoginRequestValidationError is the validation error returned by
+// AdminLoginRequest.Validate if the designated constraints aren't met.
+type AdminLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7264,24 +14267,24 @@ type AdminVipUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateRequestValidationError) ErrorName() string {
-	return "AdminVipUpdateRequestValidationError"
+func (e AdminLoginRequestValidationError) ErrorName() string {
+	return "AdminLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateRequestValidationError) Error() string {
+func (e AdminLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7293,14 +14296,14 @@ func (e AdminVipUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateRequest.%s: %s%s",
+		"invalid %sAdminLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateRequestValidationError{}
+var _ error = AdminLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7308,44 +14311,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateRequestValidationError{}
+} = AdminLoginRequestValidationError{}
 
-// Validate checks the field values on AdminVipUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateReply) Validate() error {
+// Validate checks the field values on AdminLoginReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateReply with the rules
+// ValidateAll checks the field values on AdminLoginReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateReplyMultiError, or nil if none found.
-func (m *AdminVipUpdateReply) ValidateAll() error {
+// AdminLoginReplyMultiError, or nil if none found.
+func (m *AdminLoginReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateReply) validate(all bool) error {
+func (m *AdminLoginReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return AdminVipUpdateReplyMultiError(errors)
+		return AdminLoginReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminVipUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminVipUpdateReplyMultiError []error
+// AdminLoginReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLoginReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateReplyMultiError) Error() string {
+func (m AdminLoginReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7354,11 +14359,11 @@ func (m AdminVipUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateReplyValidationError is the validation error returned by
-// AdminVipUpdateReply.Validate if the designated constraints aren't met.
-type AdminVipUpdateReplyValidationError struct {
+// AdminLoginReplyValidationError is the validation error returned by
+// AdminLoginReply.Validate if the designated constraints aren't met.
+type AdminLoginReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7366,24 +14371,22 @@ type AdminVipUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminLoginReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminLoginReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateReplyValidationError) ErrorName() string {
-	return "AdminVipUpdateReplyValidationError"
-}
+func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateReplyValidationError) Error() string {
+func (e AdminLoginReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7395,14 +14398,14 @@ func (e AdminVipUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateReply.%s: %s%s",
+		"invalid %sAdminLoginReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateReplyValidationError{}
+var _ error = AdminLoginReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7410,24 +14413,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateReplyValidationError{}
+} = AdminLoginReplyValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminChangePasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateRequest) Validate() error {
+func (m *AdminChangePasswordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminChangePasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateRequestMultiError, or nil if none found.
-func (m *AdminUndoUpdateRequest) ValidateAll() error {
+// AdminChangePasswordRequestMultiError, or nil if none found.
+func (m *AdminChangePasswordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateRequest) validate(all bool) error {
+func (m *AdminChangePasswordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7438,7 +14441,7 @@ func (m *AdminUndoUpdateRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminUndoUpdateRequestValidationError{
+				errors = append(errors, AdminChangePasswordRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7446,7 +14449,7 @@ func (m *AdminUndoUpdateRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminUndoUpdateRequestValidationError{
+				errors = append(errors, AdminChangePasswordRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7455,7 +14458,7 @@ func (m *AdminUndoUpdateRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminUndoUpdateRequestValidationError{
+			return AdminChangePasswordRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7464,19 +14467,19 @@ func (m *AdminUndoUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateRequestMultiError(errors)
+		return AdminChangePasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUndoUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUndoUpdateRequestMultiError []error
+// AdminChangePasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminChangePasswordRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminChangePasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateRequestMultiError) Error() string {
+func (m AdminChangePasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7485,11 +14488,11 @@ func (m AdminUndoUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminChangePasswordRequestMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateRequestValidationError is the validation error returned by
-// AdminUndoUpdateRequest.Validate if the designated constraints aren't met.
-type AdminUndoUpdateRequestValidationError struct {
+// AdminChangePasswordRequestValidationError is the validation error returned
+// by AdminChangePasswordRequest.Validate if the designated constraints aren't met.
+type AdminChangePasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7497,24 +14500,24 @@ type AdminUndoUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminChangePasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminChangePasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminChangePasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminChangePasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateRequestValidationError) ErrorName() string {
-	return "AdminUndoUpdateRequestValidationError"
+func (e AdminChangePasswordRequestValidationError) ErrorName() string {
+	return "AdminChangePasswordRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateRequestValidationError) Error() string {
+func (e AdminChangePasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7526,14 +14529,14 @@ func (e AdminUndoUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateRequest.%s: %s%s",
+		"invalid %sAdminChangePasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateRequestValidationError{}
+var _ error = AdminChangePasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7541,24 +14544,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateRequestValidationError{}
+} = AdminChangePasswordRequestValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateReply with the rules
+// Validate checks the field values on AdminChangePasswordReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateReply) Validate() error {
+func (m *AdminChangePasswordReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminChangePasswordReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateReplyMultiError, or nil if none found.
-func (m *AdminUndoUpdateReply) ValidateAll() error {
+// AdminChangePasswordReplyMultiError, or nil if none found.
+func (m *AdminChangePasswordReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateReply) validate(all bool) error {
+func (m *AdminChangePasswordReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7566,19 +14569,19 @@ func (m *AdminUndoUpdateReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateReplyMultiError(errors)
+		return AdminChangePasswordReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUndoUpdateReply.ValidateAll() if the designated
+// AdminChangePasswordReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminChangePasswordReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUndoUpdateReplyMultiError []error
+type AdminChangePasswordReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateReplyMultiError) Error() string {
+func (m AdminChangePasswordReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7587,11 +14590,11 @@ func (m AdminUndoUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminChangePasswordReplyMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateReplyValidationError is the validation error returned by
-// AdminUndoUpdateReply.Validate if the designated constraints aren't met.
-type AdminUndoUpdateReplyValidationError struct {
+// AdminChangePasswordReplyValidationError is the validation error returned by
+// AdminChangePasswordReply.Validate if the designated constraints aren't met.
+type AdminChangePasswordReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7599,24 +14602,24 @@ type AdminUndoUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminChangePasswordReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminChangePasswordReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminChangePasswordReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminChangePasswordReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateReplyValidationError) ErrorName() string {
-	return "AdminUndoUpdateReplyValidationError"
+func (e AdminChangePasswordReplyValidationError) ErrorName() string {
+	return "AdminChangePasswordReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateReplyValidationError) Error() string {
+func (e AdminChangePasswordReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7628,14 +14631,14 @@ func (e AdminUndoUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateReply.%s: %s%s",
+		"invalid %sAdminChangePasswordReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateReplyValidationError{}
+var _ error = AdminChangePasswordReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7643,24 +14646,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateReplyValidationError{}
+} = AdminChangePasswordReplyValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminCreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminAreaLevelUpdateRequest) Validate() error {
+func (m *AdminCreateAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateRequest with the
+// ValidateAll checks the field values on AdminCreateAccountRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateRequestMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateRequest) ValidateAll() error {
+// AdminCreateAccountRequestMultiError, or nil if none found.
+func (m *AdminCreateAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
+func (m *AdminCreateAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7671,7 +14674,7 @@ func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+				errors = append(errors, AdminCreateAccountRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7679,7 +14682,7 @@ func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+				errors = append(errors, AdminCreateAccountRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7688,7 +14691,7 @@ func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminAreaLevelUpdateRequestValidationError{
+			return AdminCreateAccountRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7697,19 +14700,19 @@ func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateRequestMultiError(errors)
+		return AdminCreateAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminAreaLevelUpdateRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminAreaLevelUpdateRequestMultiError []error
+// AdminCreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAccountRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminCreateAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
+func (m AdminCreateAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7718,12 +14721,11 @@ func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountRequestMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateRequestValidationError is the validation error returned
-// by AdminAreaLevelUpdateRequest.Validate if the designated constraints
-// aren't met.
-type AdminAreaLevelUpdateRequestValidationError struct {
+// AdminCreateAccountRequestValidationError is the validation error returned by
+// AdminCreateAccountRequest.Validate if the designated constraints aren't met.
+type AdminCreateAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7731,24 +14733,24 @@ type AdminAreaLevelUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminCreateAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateRequestValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateRequestValidationError"
+func (e AdminCreateAccountRequestValidationError) ErrorName() string {
+	return "AdminCreateAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
+func (e AdminCreateAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7760,14 +14762,14 @@ func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateRequest.%s: %s%s",
+		"invalid %sAdminCreateAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateRequestValidationError{}
+var _ error = AdminCreateAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7775,24 +14777,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateRequestValidationError{}
+} = AdminCreateAccountRequestValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateReply with the rules
+// Validate checks the field values on AdminCreateAccountReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminAreaLevelUpdateReply) Validate() error {
+func (m *AdminCreateAccountReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateReply with the
+// ValidateAll checks the field values on AdminCreateAccountReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateReplyMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateReply) ValidateAll() error {
+// AdminCreateAccountReplyMultiError, or nil if none found.
+func (m *AdminCreateAccountReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateReply) validate(all bool) error {
+func (m *AdminCreateAccountReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7800,19 +14802,19 @@ func (m *AdminAreaLevelUpdateReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateReplyMultiError(errors)
+		return AdminCreateAccountReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminAreaLevelUpdateReply.ValidateAll() if the
-// designated constraints aren't met.
-type AdminAreaLevelUpdateReplyMultiError []error
+// AdminCreateAccountReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAccountReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCreateAccountReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
+func (m AdminCreateAccountReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7821,11 +14823,11 @@ func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountReplyMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateReplyValidationError is the validation error returned by
-// AdminAreaLevelUpdateReply.Validate if the designated constraints aren't met.
-type AdminAreaLevelUpdateReplyValidationError struct {
+// AdminCreateAccountReplyValidationError is the validation error returned by
+// AdminCreateAccountReply.Validate if the designated constraints aren't met.
+type AdminCreateAccountReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7833,24 +14835,24 @@ type AdminAreaLevelUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminCreateAccountReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateReplyValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateReplyValidationError"
+func (e AdminCreateAccountReplyValidationError) ErrorName() string {
+	return "AdminCreateAccountReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
+func (e AdminCreateAccountReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7862,14 +14864,14 @@ func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateReply.%s: %s%s",
+		"invalid %sAdminCreateAccountReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateReplyValidationError{}
+var _ error = AdminCreateAccountReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7877,73 +14879,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateReplyValidationError{}
+} = AdminCreateAccountReplyValidationError{}
 
-// Validate checks the field values on AdminLocationInsertRequest with the
+// Validate checks the field values on AdminDailyLocationRewardRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationInsertRequest) Validate() error {
+func (m *AdminDailyLocationRewardRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertRequestMultiError, or nil if none found.
-func (m *AdminLocationInsertRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyLocationRewardRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyLocationRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertRequest) validate(all bool) error {
+func (m *AdminDailyLocationRewardRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminLocationInsertRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminLocationInsertRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminLocationInsertRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Date
 
 	if len(errors) > 0 {
-		return AdminLocationInsertRequestMultiError(errors)
+		return AdminDailyLocationRewardRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminLocationInsertRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminLocationInsertRequestMultiError []error
+// AdminDailyLocationRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyLocationRewardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyLocationRewardRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertRequestMultiError) Error() string {
+func (m AdminDailyLocationRewardRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7952,11 +14927,12 @@ func (m AdminLocationInsertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertRequestValidationError is the validation error returned
-// by AdminLocationInsertRequest.Validate if the designated constraints aren't met.
-type AdminLocationInsertRequestValidationError struct {
+// AdminDailyLocationRewardRequestValidationError is the validation error
+// returned by AdminDailyLocationRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyLocationRewardRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7964,24 +14940,24 @@ type AdminLocationInsertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertRequestValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertRequestValidationError) ErrorName() string {
-	return "AdminLocationInsertRequestValidationError"
+func (e AdminDailyLocationRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertRequestValidationError) Error() string {
+func (e AdminDailyLocationRewardRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7993,14 +14969,14 @@ func (e AdminLocationInsertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertRequest.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertRequestValidationError{}
+var _ error = AdminDailyLocationRewardRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8008,24 +14984,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertRequestValidationError{}
+} = AdminDailyLocationRewardRequestValidationError{}
 
-// Validate checks the field values on AdminLocationInsertReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDailyLocationRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationInsertReply) Validate() error {
+func (m *AdminDailyLocationRewardReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertReplyMultiError, or nil if none found.
-func (m *AdminLocationInsertReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyLocationRewardReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyLocationRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertReply) validate(all bool) error {
+func (m *AdminDailyLocationRewardReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8033,19 +15009,19 @@ func (m *AdminLocationInsertReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminLocationInsertReplyMultiError(errors)
+		return AdminDailyLocationRewardReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationInsertReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationInsertReplyMultiError []error
+// AdminDailyLocationRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyLocationRewardReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyLocationRewardReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertReplyMultiError) Error() string {
+func (m AdminDailyLocationRewardReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8054,11 +15030,12 @@ func (m AdminLocationInsertReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertReplyValidationError is the validation error returned by
-// AdminLocationInsertReply.Validate if the designated constraints aren't met.
-type AdminLocationInsertReplyValidationError struct {
+// AdminDailyLocationRewardReplyValidationError is the validation error
+// returned by AdminDailyLocationRewardReply.Validate if the designated
+// constraints aren't met.
+type AdminDailyLocationRewardReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8066,24 +15043,24 @@ type AdminLocationInsertReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertReplyValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertReplyValidationError) ErrorName() string {
-	return "AdminLocationInsertReplyValidationError"
+func (e AdminDailyLocationRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertReplyValidationError) Error() string {
+func (e AdminDailyLocationRewardReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8095,14 +15072,14 @@ func (e AdminLocationInsertReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertReply.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertReplyValidationError{}
+var _ error = AdminDailyLocationRewardReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8110,73 +15087,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertReplyValidationError{}
+} = AdminDailyLocationRewardReplyValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminBalanceUpdateRequest) Validate() error {
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateRequest with the
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateRequestMultiError, or nil if none found.
-func (m *AdminBalanceUpdateRequest) ValidateAll() error {
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateRequest) validate(all bool) error {
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminBalanceUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminBalanceUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminBalanceUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Address
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Signature
 
 	if len(errors) > 0 {
-		return AdminBalanceUpdateRequestMultiError(errors)
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminBalanceUpdateRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminBalanceUpdateRequestMultiError []error
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateRequestMultiError) Error() string {
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8185,11 +15141,12 @@ func (m AdminBalanceUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateRequestMultiError) AllErrors() []error { return m }
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateRequestValidationError is the validation error returned by
-// AdminBalanceUpdateRequest.Validate if the designated constraints aren't met.
-type AdminBalanceUpdateRequestValidationError struct {
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8197,24 +15154,24 @@ type AdminBalanceUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateRequestValidationError) Field() string { return e.field }
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateRequestValidationError) Reason() string { return e.reason }
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateRequestValidationError) Cause() error { return e.cause }
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateRequestValidationError) Key() bool { return e.key }
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateRequestValidationError) ErrorName() string {
-	return "AdminBalanceUpdateRequestValidationError"
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateRequestValidationError) Error() string {
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8226,14 +15183,14 @@ func (e AdminBalanceUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateRequest.%s: %s%s",
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateRequestValidationError{}
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8241,44 +15198,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateRequestValidationError{}
+} = EthAuthorizeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateReply with the rules
+// Validate checks the field values on UserInfoReply_Token with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminBalanceUpdateReply) Validate() error {
+func (m *UserInfoReply_Token) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_Token with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateReplyMultiError, or nil if none found.
-func (m *AdminBalanceUpdateReply) ValidateAll() error {
+// UserInfoReply_TokenMultiError, or nil if none found.
+func (m *UserInfoReply_Token) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateReply) validate(all bool) error {
+func (m *UserInfoReply_Token) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Symbol
+
+	// no validation rules for Contract
+
+	// no validation rules for Decimals
+
+	// no validation rules for ChainId
+
 	if len(errors) > 0 {
-		return AdminBalanceUpdateReplyMultiError(errors)
+		return UserInfoReply_TokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminBalanceUpdateReply.ValidateAll() if the designated
+// UserInfoReply_TokenMultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_Token.ValidateAll() if the designated
 // constraints aren't met.
-type AdminBalanceUpdateReplyMultiError []error
+type UserInfoReply_TokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateReplyMultiError) Error() string {
+func (m UserInfoReply_TokenMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8287,11 +15252,11 @@ func (m AdminBalanceUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_TokenMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateReplyValidationError is the validation error returned by
-// AdminBalanceUpdateReply.Validate if the designated constraints aren't met.
-type AdminBalanceUpdateReplyValidationError struct {
+// UserInfoReply_TokenValidationError is the validation error returned by
+// UserInfoReply_Token.Validate if the designated constraints aren't met.
+type UserInfoReply_TokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8299,24 +15264,24 @@ type AdminBalanceUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_TokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_TokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_TokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_TokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateReplyValidationError) ErrorName() string {
-	return "AdminBalanceUpdateReplyValidationError"
+func (e UserInfoReply_TokenValidationError) ErrorName() string {
+	return "UserInfoReply_TokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateReplyValidationError) Error() string {
+func (e UserInfoReply_TokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8328,14 +15293,14 @@ func (e AdminBalanceUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateReply.%s: %s%s",
+		"invalid %sUserInfoReply_Token.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateReplyValidationError{}
+var _ error = UserInfoReply_TokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -8343,73 +15308,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateReplyValidationError{}
+} = UserInfoReply_TokenValidationError{}
 
-// Validate checks the field values on AuthAdminCreateRequest with the rules
+// Validate checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateRequest) Validate() error {
+func (m *RewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateRequest with the rules
+// ValidateAll checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateRequestMultiError, or nil if none found.
-func (m *AuthAdminCreateRequest) ValidateAll() error {
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateRequest) validate(all bool) error {
+func (m *RewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthAdminCreateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthAdminCreateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthAdminCreateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AuthAdminCreateRequestMultiError(errors)
+		return RewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminCreateRequest.ValidateAll() if the designated
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminCreateRequestMultiError []error
+type RewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateRequestMultiError) Error() string {
+func (m RewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8418,11 +15366,11 @@ func (m AuthAdminCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateRequestMultiError) AllErrors() []error { return m }
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateRequestValidationError is the validation error returned by
-// AuthAdminCreateRequest.Validate if the designated constraints aren't met.
-type AuthAdminCreateRequestValidationError struct {
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8430,24 +15378,24 @@ type AuthAdminCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateRequestValidationError) Field() string { return e.field }
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateRequestValidationError) Reason() string { return e.reason }
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateRequestValidationError) Cause() error { return e.cause }
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateRequestValidationError) Key() bool { return e.key }
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateRequestValidationError) ErrorName() string {
-	return "AuthAdminCreateRequestValidationError"
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateRequestValidationError) Error() string {
+func (e RewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8459,14 +15407,14 @@ func (e AuthAdminCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateRequest.%s: %s%s",
+		"invalid %sRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateRequestValidationError{}
+var _ error = RewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8474,44 +15422,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateRequestValidationError{}
+} = RewardListReply_ListValidationError{}
 
-// Validate checks the field values on AuthAdminCreateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RecommendRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateReply) Validate() error {
+func (m *RecommendRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateReplyMultiError, or nil if none found.
-func (m *AuthAdminCreateReply) ValidateAll() error {
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateReply) validate(all bool) error {
+func (m *RecommendRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AuthAdminCreateReplyMultiError(errors)
+		return RecommendRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateReplyMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminCreateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AuthAdminCreateReplyMultiError []error
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateReplyMultiError) Error() string {
+func (m RecommendRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8520,11 +15478,12 @@ func (m AuthAdminCreateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateReplyMultiError) AllErrors() []error { return m }
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateReplyValidationError is the validation error returned by
-// AuthAdminCreateReply.Validate if the designated constraints aren't met.
-type AuthAdminCreateReplyValidationError struct {
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8532,24 +15491,24 @@ type AuthAdminCreateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateReplyValidationError) Field() string { return e.field }
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateReplyValidationError) Reason() string { return e.reason }
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateReplyValidationError) Cause() error { return e.cause }
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateReplyValidationError) Key() bool { return e.key }
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateReplyValidationError) ErrorName() string {
-	return "AuthAdminCreateReplyValidationError"
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateReplyValidationError) Error() string {
+func (e RecommendRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8561,14 +15520,14 @@ func (e AuthAdminCreateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateReply.%s: %s%s",
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateReplyValidationError{}
+var _ error = RecommendRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8576,73 +15535,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateReplyValidationError{}
+} = RecommendRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteRequest with the rules
+// Validate checks the field values on FeeRewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteRequest) Validate() error {
+func (m *FeeRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteRequestMultiError, or nil if none found.
-func (m *AuthAdminDeleteRequest) ValidateAll() error {
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteRequest) validate(all bool) error {
+func (m *FeeRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthAdminDeleteRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthAdminDeleteRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthAdminDeleteRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AuthAdminDeleteRequestMultiError(errors)
+		return FeeRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminDeleteRequest.ValidateAll() if the designated
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminDeleteRequestMultiError []error
+type FeeRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteRequestMultiError) Error() string {
+func (m FeeRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8651,11 +15591,11 @@ func (m AuthAdminDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteRequestMultiError) AllErrors() []error { return m }
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteRequestValidationError is the validation error returned by
-// AuthAdminDeleteRequest.Validate if the designated constraints aren't met.
-type AuthAdminDeleteRequestValidationError struct {
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8663,24 +15603,24 @@ type AuthAdminDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteRequestValidationError) Field() string { return e.field }
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteRequestValidationError) Reason() string { return e.reason }
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteRequestValidationError) Cause() error { return e.cause }
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteRequestValidationError) Key() bool { return e.key }
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteRequestValidationError) ErrorName() string {
-	return "AuthAdminDeleteRequestValidationError"
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteRequestValidationError) Error() string {
+func (e FeeRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8692,14 +15632,14 @@ func (e AuthAdminDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteRequest.%s: %s%s",
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteRequestValidationError{}
+var _ error = FeeRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8707,44 +15647,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteRequestValidationError{}
+} = FeeRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteReply with the rules
+// Validate checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteReply) Validate() error {
+func (m *WithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteReply with the rules
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteReplyMultiError, or nil if none found.
-func (m *AuthAdminDeleteReply) ValidateAll() error {
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteReply) validate(all bool) error {
+func (m *WithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return AuthAdminDeleteReplyMultiError(errors)
+		return WithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteReplyMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminDeleteReply.ValidateAll() if the designated
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminDeleteReplyMultiError []error
+type WithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteReplyMultiError) Error() string {
+func (m WithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8753,11 +15701,11 @@ func (m AuthAdminDeleteReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteReplyValidationError is the validation error returned by
-// AuthAdminDeleteReply.Validate if the designated constraints aren't met.
-type AuthAdminDeleteReplyValidationError struct {
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8765,24 +15713,24 @@ type AuthAdminDeleteReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteReplyValidationError) Field() string { return e.field }
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteReplyValidationError) Key() bool { return e.key }
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteReplyValidationError) ErrorName() string {
-	return "AuthAdminDeleteReplyValidationError"
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteReplyValidationError) Error() string {
+func (e WithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8794,14 +15742,14 @@ func (e AuthAdminDeleteReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteReply.%s: %s%s",
+		"invalid %sWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteReplyValidationError{}
+var _ error = WithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8809,46 +15757,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteReplyValidationError{}
+} = WithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on CheckAndInsertRecommendAreaRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckAndInsertRecommendAreaRequest) Validate() error {
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAndInsertRecommendAreaRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckAndInsertRecommendAreaRequestMultiError, or nil if none found.
-func (m *CheckAndInsertRecommendAreaRequest) ValidateAll() error {
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertRecommendAreaRequest) validate(all bool) error {
+func (m *RecommendListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return CheckAndInsertRecommendAreaRequestMultiError(errors)
+		return RecommendListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertRecommendAreaRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckAndInsertRecommendAreaRequest.ValidateAll() if the designated
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type CheckAndInsertRecommendAreaRequestMultiError []error
+type RecommendListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
+func (m RecommendListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8857,12 +15807,11 @@ func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertRecommendAreaRequestMultiError) AllErrors() []error { return m }
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertRecommendAreaRequestValidationError is the validation error
-// returned by CheckAndInsertRecommendAreaRequest.Validate if the designated
-// constraints aren't met.
-type CheckAndInsertRecommendAreaRequestValidationError struct {
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8870,24 +15819,24 @@ type CheckAndInsertRecommendAreaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Field() string { return e.field }
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Reason() string { return e.reason }
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Cause() error { return e.cause }
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Key() bool { return e.key }
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertRecommendAreaRequestValidationError) ErrorName() string {
-	return "CheckAndInsertRecommendAreaRequestValidationError"
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
+func (e RecommendListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8899,14 +15848,14 @@ func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertRecommendAreaRequest.%s: %s%s",
+		"invalid %sRecommendListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertRecommendAreaRequestValidationError{}
+var _ error = RecommendListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8914,46 +15863,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertRecommendAreaRequestValidationError{}
+} = RecommendListReply_ListValidationError{}
 
-// Validate checks the field values on CheckAndInsertRecommendAreaReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckAndInsertRecommendAreaReply) Validate() error {
+// Validate checks the field values on ProductListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProductListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAndInsertRecommendAreaReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckAndInsertRecommendAreaReplyMultiError, or nil if none found.
-func (m *CheckAndInsertRecommendAreaReply) ValidateAll() error {
+// ValidateAll checks the field values on ProductListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductListReply_ListMultiError, or nil if none found.
+func (m *ProductListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertRecommendAreaReply) validate(all bool) error {
+func (m *ProductListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for MinAmount
+
+	// no validation rules for MaxAmount
+
+	// no validation rules for OutRate
+
+	// no validation rules for LocationRewardRate
+
 	if len(errors) > 0 {
-		return CheckAndInsertRecommendAreaReplyMultiError(errors)
+		return ProductListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertRecommendAreaReplyMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckAndInsertRecommendAreaReply.ValidateAll() if the designated
+// ProductListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by ProductListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type CheckAndInsertRecommendAreaReplyMultiError []error
+type ProductListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
+func (m ProductListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8962,12 +15921,11 @@ func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertRecommendAreaReplyMultiError) AllErrors() []error { return m }
+func (m ProductListReply_ListMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertRecommendAreaReplyValidationError is the validation error
-// returned by CheckAndInsertRecommendAreaReply.Validate if the designated
-// constraints aren't met.
-type CheckAndInsertRecommendAreaReplyValidationError struct {
+// ProductListReply_ListValidationError is the validation error returned by
+// ProductListReply_List.Validate if the designated constraints aren't met.
+type ProductListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8975,24 +15933,24 @@ type CheckAndInsertRecommendAreaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Field() string { return e.field }
+func (e ProductListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Reason() string { return e.reason }
+func (e ProductListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Cause() error { return e.cause }
+func (e ProductListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Key() bool { return e.key }
+func (e ProductListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertRecommendAreaReplyValidationError) ErrorName() string {
-	return "CheckAndInsertRecommendAreaReplyValidationError"
+func (e ProductListReply_ListValidationError) ErrorName() string {
+	return "ProductListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
+func (e ProductListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9004,14 +15962,14 @@ func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertRecommendAreaReply.%s: %s%s",
+		"invalid %sProductListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertRecommendAreaReplyValidationError{}
+var _ error = ProductListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9019,48 +15977,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertRecommendAreaReplyValidationError{}
+} = ProductListReply_ListValidationError{}
 
-// Validate checks the field values on AdminDailyRecommendRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminDailyRecommendRewardRequest) Validate() error {
+// Validate checks the field values on DepositProductRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositProductRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyRecommendRewardRequest with
+// ValidateAll checks the field values on DepositProductRequest_SendBody with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AdminDailyRecommendRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyRecommendRewardRequest) ValidateAll() error {
+// DepositProductRequest_SendBodyMultiError, or nil if none found.
+func (m *DepositProductRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyRecommendRewardRequest) validate(all bool) error {
+func (m *DepositProductRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Day
+	// no validation rules for ProductId
 
 	if len(errors) > 0 {
-		return AdminDailyRecommendRewardRequestMultiError(errors)
+		return DepositProductRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyRecommendRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminDailyRecommendRewardRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminDailyRecommendRewardRequestMultiError []error
+// DepositProductRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by DepositProductRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type DepositProductRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
+func (m DepositProductRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9069,12 +16025,12 @@ func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyRecommendRewardRequestMultiError) AllErrors() []error { return m }
+func (m DepositProductRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminDailyRecommendRewardRequestValidationError is the validation error
-// returned by AdminDailyRecommendRewardRequest.Validate if the designated
+// DepositProductRequest_SendBodyValidationError is the validation error
+// returned by DepositProductRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminDailyRecommendRewardRequestValidationError struct {
+type DepositProductRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9082,24 +16038,24 @@ type AdminDailyRecommendRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyRecommendRewardRequestValidationError) Field() string { return e.field }
+func (e DepositProductRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyRecommendRewardRequestValidationError) Reason() string { return e.reason }
+func (e DepositProductRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyRecommendRewardRequestValidationError) Cause() error { return e.cause }
+func (e DepositProductRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyRecommendRewardRequestValidationError) Key() bool { return e.key }
+func (e DepositProductRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyRecommendRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyRecommendRewardRequestValidationError"
+func (e DepositProductRequest_SendBodyValidationError) ErrorName() string {
+	return "DepositProductRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
+func (e DepositProductRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9111,14 +16067,14 @@ func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyRecommendRewardRequest.%s: %s%s",
+		"invalid %sDepositProductRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyRecommendRewardRequestValidationError{}
+var _ error = DepositProductRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9126,44 +16082,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyRecommendRewardRequestValidationError{}
+} = DepositProductRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminDailyRecommendRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyRecommendRewardReply) Validate() error {
+func (m *WithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyRecommendRewardReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyRecommendRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyRecommendRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyRecommendRewardReply) validate(all bool) error {
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminDailyRecommendRewardReplyMultiError(errors)
+		return WithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyRecommendRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyRecommendRewardReply.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyRecommendRewardReplyMultiError []error
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9172,12 +16132,11 @@ func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyRecommendRewardReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminDailyRecommendRewardReplyValidationError is the validation error
-// returned by AdminDailyRecommendRewardReply.Validate if the designated
-// constraints aren't met.
-type AdminDailyRecommendRewardReplyValidationError struct {
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9185,24 +16144,24 @@ type AdminDailyRecommendRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyRecommendRewardReplyValidationError) Field() string { return e.field }
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyRecommendRewardReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyRecommendRewardReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyRecommendRewardReplyValidationError) Key() bool { return e.key }
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyRecommendRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyRecommendRewardReplyValidationError"
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9214,14 +16173,14 @@ func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyRecommendRewardReply.%s: %s%s",
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyRecommendRewardReplyValidationError{}
+var _ error = WithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9229,46 +16188,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyRecommendRewardReplyValidationError{}
+} = WithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminDailyBalanceRewardRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyBalanceRewardRequest) Validate() error {
+func (m *AdminRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyBalanceRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyBalanceRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyBalanceRewardRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyBalanceRewardRequest) validate(all bool) error {
+func (m *AdminRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Date
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Address
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminDailyBalanceRewardRequestMultiError(errors)
+		return AdminRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyBalanceRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyBalanceRewardRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyBalanceRewardRequestMultiError []error
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
+func (m AdminRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9277,12 +16244,11 @@ func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminDailyBalanceRewardRequestValidationError is the validation error
-// returned by AdminDailyBalanceRewardRequest.Validate if the designated
-// constraints aren't met.
-type AdminDailyBalanceRewardRequestValidationError struct {
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9290,24 +16256,24 @@ type AdminDailyBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyBalanceRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyBalanceRewardRequestValidationError"
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
+func (e AdminRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9319,14 +16285,14 @@ func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyBalanceRewardRequest.%s: %s%s",
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyBalanceRewardRequestValidationError{}
+var _ error = AdminRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9334,44 +16300,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyBalanceRewardRequestValidationError{}
+} = AdminRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminDailyBalanceRewardReply with the
+// Validate checks the field values on AdminUserListReply_UserList with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyBalanceRewardReply) Validate() error {
+func (m *AdminUserListReply_UserList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyBalanceRewardReply with the
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminDailyBalanceRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyBalanceRewardReply) ValidateAll() error {
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyBalanceRewardReply) validate(all bool) error {
+func (m *AdminUserListReply_UserList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Vip
+
+	// no validation rules for MonthRecommend
+
+	// no validation rules for HistoryRecommend
+
+	// no validation rules for AreaAmount
+
+	// no validation rules for AreaMaxAmount
+
 	if len(errors) > 0 {
-		return AdminDailyBalanceRewardReplyMultiError(errors)
+		return AdminUserListReply_UserListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyBalanceRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyBalanceRewardReply.ValidateAll() if
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
 // the designated constraints aren't met.
-type AdminDailyBalanceRewardReplyMultiError []error
+type AdminUserListReply_UserListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
+func (m AdminUserListReply_UserListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9380,12 +16366,12 @@ func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
 
-// AdminDailyBalanceRewardReplyValidationError is the validation error returned
-// by AdminDailyBalanceRewardReply.Validate if the designated constraints
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
 // aren't met.
-type AdminDailyBalanceRewardReplyValidationError struct {
+type AdminUserListReply_UserListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9393,24 +16379,24 @@ type AdminDailyBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyBalanceRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyBalanceRewardReplyValidationError"
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
+func (e AdminUserListReply_UserListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9422,14 +16408,14 @@ func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyBalanceRewardReply.%s: %s%s",
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyBalanceRewardReplyValidationError{}
+var _ error = AdminUserListReply_UserListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9437,73 +16423,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyBalanceRewardReplyValidationError{}
+} = AdminUserListReply_UserListValidationError{}
 
-// Validate checks the field values on AdminLoginRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginRequest) Validate() error {
+// Validate checks the field values on AdminLocationListReply_LocationList with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLoginRequestMultiError, or nil if none found.
-func (m *AdminLoginRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginRequest) validate(all bool) error {
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminLoginRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminLoginRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminLoginRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
 
 	if len(errors) > 0 {
-		return AdminLoginRequestMultiError(errors)
+		return AdminLocationListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginRequestMultiError []error
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginRequestMultiError) Error() string {
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9512,11 +16487,12 @@ func (m AdminLoginRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminLoginRequestValidationError is the validation error returned by
-// AdminLoginRequest.Validate if the designated constraints aren't met.
-type AdminLoginRequestValidationError struct {
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9524,24 +16500,24 @@ type AdminLoginRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginRequestValidationError) Field() string { return e.field }
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginRequestValidationError) ErrorName() string {
-	return "AdminLoginRequestValidationError"
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginRequestValidationError) Error() string {
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9553,14 +16529,14 @@ func (e AdminLoginRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginRequest.%s: %s%s",
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginRequestValidationError{}
+var _ error = AdminLocationListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9568,46 +16544,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginRequestValidationError{}
+} = AdminLocationListReply_LocationListValidationError{}
 
-// Validate checks the field values on AdminLoginReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginReply) Validate() error {
+// Validate checks the field values on AdminLocationAllListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminLocationAllListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLoginReplyMultiError, or nil if none found.
-func (m *AdminLoginReply) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminLocationAllListReply_LocationList with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginReply) validate(all bool) error {
+func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
 
 	if len(errors) > 0 {
-		return AdminLoginReplyMultiError(errors)
+		return AdminLocationAllListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginReplyMultiError []error
+// AdminLocationAllListReply_LocationListMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationAllListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginReplyMultiError) Error() string {
+func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9616,11 +16609,12 @@ func (m AdminLoginReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminLoginReplyValidationError is the validation error returned by
-// AdminLoginReply.Validate if the designated constraints aren't met.
-type AdminLoginReplyValidationError struct {
+// AdminLocationAllListReply_LocationListValidationError is the validation
+// error returned by AdminLocationAllListReply_LocationList.Validate if the
+// designated constraints aren't met.
+type AdminLocationAllListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9628,22 +16622,24 @@ type AdminLoginReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginReplyValidationError) Field() string { return e.field }
+func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
+func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationAllListReply_LocationListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminLoginReplyValidationError) Error() string {
+func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9655,14 +16651,14 @@ func (e AdminLoginReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginReply.%s: %s%s",
+		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginReplyValidationError{}
+var _ error = AdminLocationAllListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9670,73 +16666,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginReplyValidationError{}
+} = AdminLocationAllListReply_LocationListValidationError{}
 
-// Validate checks the field values on AdminChangePasswordRequest with the
+// Validate checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminChangePasswordRequest) Validate() error {
+func (m *AdminWithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordRequest with the
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordRequestMultiError, or nil if none found.
-func (m *AdminChangePasswordRequest) ValidateAll() error {
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordRequest) validate(all bool) error {
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminChangePasswordRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminChangePasswordRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminChangePasswordRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for TxHash
 
 	if len(errors) > 0 {
-		return AdminChangePasswordRequestMultiError(errors)
+		return AdminWithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminChangePasswordRequest.ValidateAll() if
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
 // the designated constraints aren't met.
-type AdminChangePasswordRequestMultiError []error
+type AdminWithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordRequestMultiError) Error() string {
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9745,11 +16730,12 @@ func (m AdminChangePasswordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordRequestValidationError is the validation error returned
-// by AdminChangePasswordRequest.Validate if the designated constraints aren't met.
-type AdminChangePasswordRequestValidationError struct {
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9757,24 +16743,24 @@ type AdminChangePasswordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordRequestValidationError) ErrorName() string {
-	return "AdminChangePasswordRequestValidationError"
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordRequestValidationError) Error() string {
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9786,14 +16772,14 @@ func (e AdminChangePasswordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordRequest.%s: %s%s",
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordRequestValidationError{}
+var _ error = AdminWithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9801,44 +16787,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordRequestValidationError{}
+} = AdminWithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on AdminChangePasswordReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminChangePasswordReply) Validate() error {
+// Validate checks the field values on AdminWithdrawPassRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawPassRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordReplyMultiError, or nil if none found.
-func (m *AdminChangePasswordReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawPassRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawPassRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawPassRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordReply) validate(all bool) error {
+func (m *AdminWithdrawPassRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminChangePasswordReplyMultiError(errors)
+		return AdminWithdrawPassRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminChangePasswordReply.ValidateAll() if the designated
+// AdminWithdrawPassRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawPassRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminChangePasswordReplyMultiError []error
+type AdminWithdrawPassRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordReplyMultiError) Error() string {
+func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9847,11 +16837,12 @@ func (m AdminChangePasswordReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawPassRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordReplyValidationError is the validation error returned by
-// AdminChangePasswordReply.Validate if the designated constraints aren't met.
-type AdminChangePasswordReplyValidationError struct {
+// AdminWithdrawPassRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawPassRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawPassRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9859,24 +16850,24 @@ type AdminChangePasswordReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordReplyValidationError) ErrorName() string {
-	return "AdminChangePasswordReplyValidationError"
+func (e AdminWithdrawPassRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawPassRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordReplyValidationError) Error() string {
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9888,14 +16879,14 @@ func (e AdminChangePasswordReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordReply.%s: %s%s",
+		"invalid %sAdminWithdrawPassRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordReplyValidationError{}
+var _ error = AdminWithdrawPassRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9903,73 +16894,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordReplyValidationError{}
+} = AdminWithdrawPassRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminCreateAccountRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminCreateAccountRequest) Validate() error {
+// Validate checks the field values on AdminWithdrawRejectRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountRequestMultiError, or nil if none found.
-func (m *AdminCreateAccountRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRejectRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountRequest) validate(all bool) error {
+func (m *AdminWithdrawRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminCreateAccountRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminCreateAccountRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminCreateAccountRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminCreateAccountRequestMultiError(errors)
+		return AdminWithdrawRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminCreateAccountRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminCreateAccountRequestMultiError []error
+// AdminWithdrawRejectRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRejectRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountRequestMultiError) Error() string {
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9978,11 +16946,12 @@ func (m AdminCreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminCreateAccountRequestValidationError is the validation error returned by
-// AdminCreateAccountRequest.Validate if the designated constraints aren't met.
-type AdminCreateAccountRequestValidationError struct {
+// AdminWithdrawRejectRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRejectRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9990,24 +16959,24 @@ type AdminCreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountRequestValidationError) ErrorName() string {
-	return "AdminCreateAccountRequestValidationError"
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountRequestValidationError) Error() string {
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10019,14 +16988,14 @@ func (e AdminCreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountRequest.%s: %s%s",
+		"invalid %sAdminWithdrawRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountRequestValidationError{}
+var _ error = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10034,44 +17003,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountRequestValidationError{}
+} = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminCreateAccountReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminCreateAccountReply) Validate() error {
+// Validate checks the field values on AdminWithdrawRefundRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRefundRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountReplyMultiError, or nil if none found.
-func (m *AdminCreateAccountReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRefundRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRefundRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountReply) validate(all bool) error {
+func (m *AdminWithdrawRefundRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminCreateAccountReplyMultiError(errors)
+		return AdminWithdrawRefundRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminCreateAccountReply.ValidateAll() if the designated
+// AdminWithdrawRefundRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRefundRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminCreateAccountReplyMultiError []error
+type AdminWithdrawRefundRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountReplyMultiError) Error() string {
+func (m AdminWithdrawRefundRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10080,11 +17053,12 @@ func (m AdminCreateAccountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRefundRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminCreateAccountReplyValidationError is the validation error returned by
-// AdminCreateAccountReply.Validate if the designated constraints aren't met.
-type AdminCreateAccountReplyValidationError struct {
+// AdminWithdrawRefundRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRefundRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRefundRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10092,24 +17066,24 @@ type AdminCreateAccountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountReplyValidationError) ErrorName() string {
-	return "AdminCreateAccountReplyValidationError"
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRefundRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountReplyValidationError) Error() string {
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10121,14 +17095,14 @@ func (e AdminCreateAccountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountReply.%s: %s%s",
+		"invalid %sAdminWithdrawRefundRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountReplyValidationError{}
+var _ error = AdminWithdrawRefundRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10136,46 +17110,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountReplyValidationError{}
+} = AdminWithdrawRefundRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardRequest with the
+// Validate checks the field values on AdminDepositReorgListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyLocationRewardRequest) Validate() error {
+func (m *AdminDepositReorgListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardRequest with
+// ValidateAll checks the field values on AdminDepositReorgListReply_List with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardRequest) ValidateAll() error {
+// AdminDepositReorgListReply_ListMultiError, or nil if none found.
+func (m *AdminDepositReorgListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardRequest) validate(all bool) error {
+func (m *AdminDepositReorgListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Date
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Hash
+
+	// no validation rules for Amount
+
+	// no validation rules for CoinType
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for BlockHash
+
+	// no validation rules for Reorg
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardRequestMultiError(errors)
+		return AdminDepositReorgListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyLocationRewardRequest.ValidateAll()
+// AdminDepositReorgListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListReply_List.ValidateAll()
 // if the designated constraints aren't met.
-type AdminDailyLocationRewardRequestMultiError []error
+type AdminDepositReorgListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardRequestMultiError) Error() string {
+func (m AdminDepositReorgListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10184,12 +17176,12 @@ func (m AdminDailyLocationRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardRequestMultiError) AllErrors() []error { return m }
+func (m AdminDepositReorgListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardRequestValidationError is the validation error
-// returned by AdminDailyLocationRewardRequest.Validate if the designated
+// AdminDepositReorgListReply_ListValidationError is the validation error
+// returned by AdminDepositReorgListReply_List.Validate if the designated
 // constraints aren't met.
-type AdminDailyLocationRewardRequestValidationError struct {
+type AdminDepositReorgListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10197,24 +17189,24 @@ type AdminDailyLocationRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardRequestValidationError) Field() string { return e.field }
+func (e AdminDepositReorgListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardRequestValidationError) Reason() string { return e.reason }
+func (e AdminDepositReorgListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardRequestValidationError) Cause() error { return e.cause }
+func (e AdminDepositReorgListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardRequestValidationError) Key() bool { return e.key }
+func (e AdminDepositReorgListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardRequestValidationError"
+func (e AdminDepositReorgListReply_ListValidationError) ErrorName() string {
+	return "AdminDepositReorgListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardRequestValidationError) Error() string {
+func (e AdminDepositReorgListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10226,14 +17218,14 @@ func (e AdminDailyLocationRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardRequest.%s: %s%s",
+		"invalid %sAdminDepositReorgListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardRequestValidationError{}
+var _ error = AdminDepositReorgListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10241,44 +17233,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardRequestValidationError{}
+} = AdminDepositReorgListReply_ListValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyLocationRewardReply) Validate() error {
+// Validate checks the field values on AdminDepositReorgResolveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminDepositReorgResolveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminDepositReorgResolveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminDepositReorgResolveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositReorgResolveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardReply) validate(all bool) error {
+func (m *AdminDepositReorgResolveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardReplyMultiError(errors)
+		return AdminDepositReorgResolveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyLocationRewardReply.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyLocationRewardReplyMultiError []error
+// AdminDepositReorgResolveRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminDepositReorgResolveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositReorgResolveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardReplyMultiError) Error() string {
+func (m AdminDepositReorgResolveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10287,12 +17284,12 @@ func (m AdminDailyLocationRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositReorgResolveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardReplyValidationError is the validation error
-// returned by AdminDailyLocationRewardReply.Validate if the designated
-// constraints aren't met.
-type AdminDailyLocationRewardReplyValidationError struct {
+// AdminDepositReorgResolveRequest_SendBodyValidationError is the validation
+// error returned by AdminDepositReorgResolveRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminDepositReorgResolveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10300,24 +17297,24 @@ type AdminDailyLocationRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardReplyValidationError) Field() string { return e.field }
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardReplyValidationError"
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositReorgResolveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardReplyValidationError) Error() string {
+func (e AdminDepositReorgResolveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10329,14 +17326,14 @@ func (e AdminDailyLocationRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardReply.%s: %s%s",
+		"invalid %sAdminDepositReorgResolveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardReplyValidationError{}
+var _ error = AdminDepositReorgResolveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10344,48 +17341,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardReplyValidationError{}
+} = AdminDepositReorgResolveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// Validate checks the field values on AdminDepositLookupReply_Record with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminDepositLookupReply_Record) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositLookupReply_Record with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReply_RecordMultiError, or nil if none found.
+func (m *AdminDepositLookupReply_Record) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminDepositLookupReply_Record) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
 	// no validation rules for Address
 
-	// no validation rules for Code
+	// no validation rules for Hash
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for CoinType
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Reorg
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for LogIndex
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositLookupReply_RecordValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositLookupReply_RecordValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositLookupReply_RecordValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBalanceRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositLookupReply_RecordValidationError{
+					field:  "BalanceRecord",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositLookupReply_RecordValidationError{
+					field:  "BalanceRecord",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalanceRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositLookupReply_RecordValidationError{
+				field:  "BalanceRecord",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminDepositLookupReply_RecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// AdminDepositLookupReply_RecordMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositLookupReply_Record.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositLookupReply_RecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminDepositLookupReply_RecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10394,12 +17469,12 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDepositLookupReply_RecordMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// AdminDepositLookupReply_RecordValidationError is the validation error
+// returned by AdminDepositLookupReply_Record.Validate if the designated
+// constraints aren't met.
+type AdminDepositLookupReply_RecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10407,24 +17482,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDepositLookupReply_RecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDepositLookupReply_RecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDepositLookupReply_RecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDepositLookupReply_RecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminDepositLookupReply_RecordValidationError) ErrorName() string {
+	return "AdminDepositLookupReply_RecordValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminDepositLookupReply_RecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10436,14 +17511,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDepositLookupReply_Record.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminDepositLookupReply_RecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -10451,52 +17526,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminDepositLookupReply_RecordValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositLookupReply_Location with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositLookupReply_Location) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositLookupReply_Location with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReply_LocationMultiError, or nil if none found.
+func (m *AdminDepositLookupReply_Location) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminDepositLookupReply_Location) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Status
 
-	// no validation rules for LocationStatus
+	// no validation rules for Current
 
-	// no validation rules for Type
+	// no validation rules for CurrentMax
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminDepositLookupReply_LocationMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
+// AdminDepositLookupReply_LocationMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositLookupReply_Location.ValidateAll() if the designated
 // constraints aren't met.
-type RewardListReply_ListMultiError []error
+type AdminDepositLookupReply_LocationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminDepositLookupReply_LocationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10505,11 +17584,12 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositLookupReply_LocationMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminDepositLookupReply_LocationValidationError is the validation error
+// returned by AdminDepositLookupReply_Location.Validate if the designated
+// constraints aren't met.
+type AdminDepositLookupReply_LocationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10517,24 +17597,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositLookupReply_LocationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositLookupReply_LocationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositLookupReply_LocationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositLookupReply_LocationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminDepositLookupReply_LocationValidationError) ErrorName() string {
+	return "AdminDepositLookupReply_LocationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminDepositLookupReply_LocationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10546,14 +17626,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminDepositLookupReply_Location.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminDepositLookupReply_LocationValidationError{}
 
 var _ interface {
 	Field() string
@@ -10561,52 +17641,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
-
-// Validate checks the field values on RecommendRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
-	return m.validate(false)
-}
+} = AdminDepositLookupReply_LocationValidationError{}
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// Validate checks the field values on AdminDepositLookupReply_BalanceRecord
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminDepositLookupReply_BalanceRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositLookupReply_BalanceRecord
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReply_BalanceRecordMultiError, or nil if none found.
+func (m *AdminDepositLookupReply_BalanceRecord) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminDepositLookupReply_BalanceRecord) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
 	// no validation rules for Amount
 
+	// no validation rules for Balance
+
 	// no validation rules for Type
 
-	// no validation rules for Reason
+	// no validation rules for CoinType
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminDepositLookupReply_BalanceRecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+// AdminDepositLookupReply_BalanceRecordMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminDepositLookupReply_BalanceRecord.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositLookupReply_BalanceRecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminDepositLookupReply_BalanceRecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10615,12 +17701,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositLookupReply_BalanceRecordMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
-// constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+// AdminDepositLookupReply_BalanceRecordValidationError is the validation error
+// returned by AdminDepositLookupReply_BalanceRecord.Validate if the
+// designated constraints aren't met.
+type AdminDepositLookupReply_BalanceRecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10628,24 +17714,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositLookupReply_BalanceRecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositLookupReply_BalanceRecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositLookupReply_BalanceRecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositLookupReply_BalanceRecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminDepositLookupReply_BalanceRecordValidationError) ErrorName() string {
+	return "AdminDepositLookupReply_BalanceRecordValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminDepositLookupReply_BalanceRecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10657,14 +17743,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminDepositLookupReply_BalanceRecord.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminDepositLookupReply_BalanceRecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -10672,48 +17758,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminDepositLookupReply_BalanceRecordValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDepositLookupReply_Reward with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+func (m *AdminDepositLookupReply_Reward) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositLookupReply_Reward with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReply_RewardMultiError, or nil if none found.
+func (m *AdminDepositLookupReply_Reward) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminDepositLookupReply_Reward) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
 
 	// no validation rules for Amount
 
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for LocationId
+
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminDepositLookupReply_RewardMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+// AdminDepositLookupReply_RewardMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositLookupReply_Reward.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositLookupReply_RewardMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminDepositLookupReply_RewardMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10722,11 +17820,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositLookupReply_RewardMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminDepositLookupReply_RewardValidationError is the validation error
+// returned by AdminDepositLookupReply_Reward.Validate if the designated
+// constraints aren't met.
+type AdminDepositLookupReply_RewardValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10734,24 +17833,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositLookupReply_RewardValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositLookupReply_RewardValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositLookupReply_RewardValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositLookupReply_RewardValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminDepositLookupReply_RewardValidationError) ErrorName() string {
+	return "AdminDepositLookupReply_RewardValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminDepositLookupReply_RewardValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10763,14 +17862,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminDepositLookupReply_Reward.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminDepositLookupReply_RewardValidationError{}
 
 var _ interface {
 	Field() string
@@ -10778,52 +17877,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminDepositLookupReply_RewardValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositLookupReply_Transfer with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositLookupReply_Transfer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositLookupReply_Transfer with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositLookupReply_TransferMultiError, or nil if none found.
+func (m *AdminDepositLookupReply_Transfer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminDepositLookupReply_Transfer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for LogIndex
 
-	// no validation rules for Type
+	// no validation rules for ToAddress
 
-	// no validation rules for Status
+	// no validation rules for Value
+
+	// no validation rules for Disposition
+
+	// no validation rules for Resolution
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminDepositLookupReply_TransferMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// AdminDepositLookupReply_TransferMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositLookupReply_Transfer.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+type AdminDepositLookupReply_TransferMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminDepositLookupReply_TransferMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10832,11 +17937,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositLookupReply_TransferMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminDepositLookupReply_TransferValidationError is the validation error
+// returned by AdminDepositLookupReply_Transfer.Validate if the designated
+// constraints aren't met.
+type AdminDepositLookupReply_TransferValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10844,24 +17950,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositLookupReply_TransferValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositLookupReply_TransferValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositLookupReply_TransferValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositLookupReply_TransferValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminDepositLookupReply_TransferValidationError) ErrorName() string {
+	return "AdminDepositLookupReply_TransferValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminDepositLookupReply_TransferValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10873,14 +17979,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminDepositLookupReply_Transfer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminDepositLookupReply_TransferValidationError{}
 
 var _ interface {
 	Field() string
@@ -10888,48 +17994,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminDepositLookupReply_TransferValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+// Validate checks the field values on AdminInboundTransferListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminInboundTransferListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminInboundTransferListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminInboundTransferListReply_ListMultiError, or nil if none found.
+func (m *AdminInboundTransferListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminInboundTransferListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Hash
+
+	// no validation rules for LogIndex
+
+	// no validation rules for FromAddress
+
+	// no validation rules for ToAddress
+
+	// no validation rules for CoinType
+
+	// no validation rules for Value
+
 	// no validation rules for Amount
 
-	// no validation rules for Status
+	// no validation rules for UserId
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Disposition
+
+	// no validation rules for Resolution
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminInboundTransferListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// AdminInboundTransferListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminInboundTransferListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendListReply_ListMultiError []error
+type AdminInboundTransferListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminInboundTransferListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10938,11 +18070,12 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminInboundTransferListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminInboundTransferListReply_ListValidationError is the validation error
+// returned by AdminInboundTransferListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminInboundTransferListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10950,24 +18083,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminInboundTransferListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminInboundTransferListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminInboundTransferListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminInboundTransferListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminInboundTransferListReply_ListValidationError) ErrorName() string {
+	return "AdminInboundTransferListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminInboundTransferListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10979,14 +18112,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminInboundTransferListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminInboundTransferListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10994,48 +18127,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminInboundTransferListReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminDepositCreditRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositCreditRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositCreditRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositCreditRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositCreditRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminDepositCreditRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for TxHash
+
+	// no validation rules for LogIndex
 
 	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminDepositCreditRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// AdminDepositCreditRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositCreditRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+type AdminDepositCreditRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminDepositCreditRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11044,11 +18181,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDepositCreditRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminDepositCreditRequest_SendBodyValidationError is the validation error
+// returned by AdminDepositCreditRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminDepositCreditRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11056,24 +18194,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDepositCreditRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDepositCreditRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDepositCreditRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDepositCreditRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminDepositCreditRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositCreditRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminDepositCreditRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11085,14 +18223,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDepositCreditRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminDepositCreditRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11100,54 +18238,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminDepositCreditRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+// Validate checks the field values on
+// AdminInboundTransferResolveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminInboundTransferResolveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminInboundTransferResolveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminInboundTransferResolveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminInboundTransferResolveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminInboundTransferResolveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Id
 
-	// no validation rules for Type
+	// no validation rules for Action
 
-	// no validation rules for Address
+	// no validation rules for UserId
 
-	// no validation rules for Reason
+	// no validation rules for Remark
 
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminInboundTransferResolveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminInboundTransferResolveRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminInboundTransferResolveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminInboundTransferResolveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminInboundTransferResolveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11156,11 +18295,12 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminInboundTransferResolveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminInboundTransferResolveRequest_SendBodyValidationError is the validation
+// error returned by AdminInboundTransferResolveRequest_SendBody.Validate if
+// the designated constraints aren't met.
+type AdminInboundTransferResolveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11168,24 +18308,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminInboundTransferResolveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminInboundTransferResolveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11197,14 +18337,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminInboundTransferResolveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminInboundTransferResolveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11212,64 +18352,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminInboundTransferResolveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+// Validate checks the field values on AdminWalletTxReplaceRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminWalletTxReplaceRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// ValidateAll checks the field values on AdminWalletTxReplaceRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWalletTxReplaceRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWalletTxReplaceRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AdminWalletTxReplaceRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for BalanceUsdt
-
-	// no validation rules for BalanceDhb
-
-	// no validation rules for Vip
-
-	// no validation rules for MonthRecommend
-
-	// no validation rules for HistoryRecommend
-
-	// no validation rules for AreaAmount
+	// no validation rules for Id
 
-	// no validation rules for AreaMaxAmount
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AdminWalletTxReplaceRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+// AdminWalletTxReplaceRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWalletTxReplaceRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWalletTxReplaceRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AdminWalletTxReplaceRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11278,12 +18404,12 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AdminWalletTxReplaceRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
-// aren't met.
-type AdminUserListReply_UserListValidationError struct {
+// AdminWalletTxReplaceRequest_SendBodyValidationError is the validation error
+// returned by AdminWalletTxReplaceRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWalletTxReplaceRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11291,24 +18417,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWalletTxReplaceRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AdminWalletTxReplaceRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11320,14 +18446,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAdminWalletTxReplaceRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AdminWalletTxReplaceRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11335,62 +18461,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AdminWalletTxReplaceRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminProductListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProductListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminProductListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProductListReply_ListMultiError, or nil if none found.
+func (m *AdminProductListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *AdminProductListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Name
 
-	// no validation rules for Row
+	// no validation rules for MinAmount
 
-	// no validation rules for Col
+	// no validation rules for MaxAmount
 
-	// no validation rules for Status
+	// no validation rules for OutRate
 
-	// no validation rules for CurrentLevel
+	// no validation rules for LocationRewardRate
 
-	// no validation rules for Current
+	// no validation rules for RewardRate
 
-	// no validation rules for CurrentMax
+	// no validation rules for CoinRewardRate
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return AdminProductListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+// AdminProductListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminProductListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminProductListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m AdminProductListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11399,12 +18529,11 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminProductListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+// AdminProductListReply_ListValidationError is the validation error returned
+// by AdminProductListReply_List.Validate if the designated constraints aren't met.
+type AdminProductListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11412,24 +18541,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminProductListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminProductListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminProductListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminProductListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e AdminProductListReply_ListValidationError) ErrorName() string {
+	return "AdminProductListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e AdminProductListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11441,14 +18570,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sAdminProductListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = AdminProductListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11456,63 +18585,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = AdminProductListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationAllListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AdminLocationAllListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminProductSaveRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminProductSaveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AdminLocationAllListReply_LocationList with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminProductSaveRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminProductSaveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminProductSaveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
+func (m *AdminProductSaveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Name
 
-	// no validation rules for Row
+	// no validation rules for MinAmount
 
-	// no validation rules for Col
+	// no validation rules for MaxAmount
 
-	// no validation rules for Status
+	// no validation rules for OutRate
 
-	// no validation rules for CurrentLevel
+	// no validation rules for LocationRewardRate
 
-	// no validation rules for Current
+	// no validation rules for RewardRate
 
-	// no validation rules for CurrentMax
+	// no validation rules for CoinRewardRate
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminLocationAllListReply_LocationListMultiError(errors)
+		return AdminProductSaveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationAllListReply_LocationListMultiError is an error wrapping
-// multiple validation errors returned by
-// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
+// AdminProductSaveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminProductSaveRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationAllListReply_LocationListMultiError []error
+type AdminProductSaveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
+func (m AdminProductSaveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11521,12 +18655,12 @@ func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminProductSaveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminLocationAllListReply_LocationListValidationError is the validation
-// error returned by AdminLocationAllListReply_LocationList.Validate if the
-// designated constraints aren't met.
-type AdminLocationAllListReply_LocationListValidationError struct {
+// AdminProductSaveRequest_SendBodyValidationError is the validation error
+// returned by AdminProductSaveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminProductSaveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11534,24 +18668,24 @@ type AdminLocationAllListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminProductSaveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminProductSaveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminProductSaveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminProductSaveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationAllListReply_LocationListValidationError"
+func (e AdminProductSaveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminProductSaveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
+func (e AdminProductSaveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11563,14 +18697,14 @@ func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
+		"invalid %sAdminProductSaveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationAllListReply_LocationListValidationError{}
+var _ error = AdminProductSaveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11578,58 +18712,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationAllListReply_LocationListValidationError{}
+} = AdminProductSaveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminTokenListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+func (m *AdminTokenListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
+// ValidateAll checks the field values on AdminTokenListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// AdminTokenListReply_ListMultiError, or nil if none found.
+func (m *AdminTokenListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminTokenListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
+	// no validation rules for Symbol
 
-	// no validation rules for Amount
+	// no validation rules for Contract
 
-	// no validation rules for RelAmount
+	// no validation rules for Decimals
 
-	// no validation rules for Type
+	// no validation rules for ChainId
 
 	// no validation rules for Status
 
+	// no validation rules for DepositRule
+
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminTokenListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminTokenListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminTokenListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTokenListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminTokenListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11638,12 +18772,11 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminTokenListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminTokenListReply_ListValidationError is the validation error returned by
+// AdminTokenListReply_List.Validate if the designated constraints aren't met.
+type AdminTokenListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11651,24 +18784,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminTokenListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminTokenListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminTokenListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminTokenListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminTokenListReply_ListValidationError) ErrorName() string {
+	return "AdminTokenListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminTokenListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11680,14 +18813,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminTokenListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminTokenListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11695,25 +18828,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminTokenListReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawPassRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminWithdrawPassRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminTokenSaveRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTokenSaveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawPassRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminWithdrawPassRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminWithdrawPassRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminTokenSaveRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminTokenSaveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminTokenSaveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawPassRequest_SendBody) validate(all bool) error {
+func (m *AdminTokenSaveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11722,21 +18854,32 @@ func (m *AdminWithdrawPassRequest_SendBody) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Symbol
+
+	// no validation rules for Contract
+
+	// no validation rules for Decimals
+
+	// no validation rules for ChainId
+
+	// no validation rules for Status
+
+	// no validation rules for DepositRule
+
 	if len(errors) > 0 {
-		return AdminWithdrawPassRequest_SendBodyMultiError(errors)
+		return AdminTokenSaveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawPassRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminWithdrawPassRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawPassRequest_SendBodyMultiError []error
+// AdminTokenSaveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminTokenSaveRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminTokenSaveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
+func (m AdminTokenSaveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11745,12 +18888,12 @@ func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawPassRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminTokenSaveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawPassRequest_SendBodyValidationError is the validation error
-// returned by AdminWithdrawPassRequest_SendBody.Validate if the designated
+// AdminTokenSaveRequest_SendBodyValidationError is the validation error
+// returned by AdminTokenSaveRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminWithdrawPassRequest_SendBodyValidationError struct {
+type AdminTokenSaveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11758,24 +18901,24 @@ type AdminWithdrawPassRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminTokenSaveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminTokenSaveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminTokenSaveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminTokenSaveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminWithdrawPassRequest_SendBodyValidationError"
+func (e AdminTokenSaveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminTokenSaveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
+func (e AdminTokenSaveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11787,14 +18930,14 @@ func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawPassRequest_SendBody.%s: %s%s",
+		"invalid %sAdminTokenSaveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawPassRequest_SendBodyValidationError{}
+var _ error = AdminTokenSaveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11802,7 +18945,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawPassRequest_SendBodyValidationError{}
+} = AdminTokenSaveRequest_SendBodyValidationError{}
 
 // Validate checks the field values on AdminUserRecommendReply_List with the
 // rules defined in the proto definition for this message. If any rules are
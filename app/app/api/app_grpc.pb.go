@@ -22,6 +22,8 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AppClient interface {
+	EthAuthorizeNonce(ctx context.Context, in *EthAuthorizeNonceRequest, opts ...grpc.CallOption) (*EthAuthorizeNonceReply, error)
+	EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...grpc.CallOption) (*EthAuthorizeReply, error)
 	UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error)
 	RewardList(ctx context.Context, in *RewardListRequest, opts ...grpc.CallOption) (*RewardListReply, error)
 	RecommendRewardList(ctx context.Context, in *RecommendRewardListRequest, opts ...grpc.CallOption) (*RecommendRewardListReply, error)
@@ -29,6 +31,9 @@ type AppClient interface {
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	ProductList(ctx context.Context, in *ProductListRequest, opts ...grpc.CallOption) (*ProductListReply, error)
+	// 选择下一笔充值的套餐
+	DepositProduct(ctx context.Context, in *DepositProductRequest, opts ...grpc.CallOption) (*DepositProductReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	Deposit2(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error)
@@ -41,6 +46,29 @@ type AppClient interface {
 	AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error)
 	AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error)
 	AdminWithdrawPass(ctx context.Context, in *AdminWithdrawPassRequest, opts ...grpc.CallOption) (*AdminWithdrawPassReply, error)
+	AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...grpc.CallOption) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawTrack(ctx context.Context, in *AdminWithdrawTrackRequest, opts ...grpc.CallOption) (*AdminWithdrawTrackReply, error)
+	AdminWalletTxCheck(ctx context.Context, in *AdminWalletTxCheckRequest, opts ...grpc.CallOption) (*AdminWalletTxCheckReply, error)
+	// 充值地址归集
+	AdminSweep(ctx context.Context, in *AdminSweepRequest, opts ...grpc.CallOption) (*AdminSweepReply, error)
+	// 已入账充值的区块回滚检查
+	AdminDepositReorgCheck(ctx context.Context, in *AdminDepositReorgCheckRequest, opts ...grpc.CallOption) (*AdminDepositReorgCheckReply, error)
+	AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...grpc.CallOption) (*AdminDepositReorgListReply, error)
+	// 回滚的充值人工冲正后标记已处理
+	AdminDepositReorgResolve(ctx context.Context, in *AdminDepositReorgResolveRequest, opts ...grpc.CallOption) (*AdminDepositReorgResolveReply, error)
+	// 按交易hash查充值的入账、仓位、余额记录和产生的收益
+	AdminDepositLookup(ctx context.Context, in *AdminDepositLookupRequest, opts ...grpc.CallOption) (*AdminDepositLookupReply, error)
+	// 扫描漏掉的充值按交易hash从链上补入账，与自动入账流程一致
+	AdminDepositCredit(ctx context.Context, in *AdminDepositCreditRequest, opts ...grpc.CallOption) (*AdminDepositCreditReply, error)
+	AdminInboundTransferList(ctx context.Context, in *AdminInboundTransferListRequest, opts ...grpc.CallOption) (*AdminInboundTransferListReply, error)
+	// 未入账的转入人工处理
+	AdminInboundTransferResolve(ctx context.Context, in *AdminInboundTransferResolveRequest, opts ...grpc.CallOption) (*AdminInboundTransferResolveReply, error)
+	AdminWalletTxReplace(ctx context.Context, in *AdminWalletTxReplaceRequest, opts ...grpc.CallOption) (*AdminWalletTxReplaceReply, error)
+	AdminProductList(ctx context.Context, in *AdminProductListRequest, opts ...grpc.CallOption) (*AdminProductListReply, error)
+	AdminProductSave(ctx context.Context, in *AdminProductSaveRequest, opts ...grpc.CallOption) (*AdminProductSaveReply, error)
+	AdminTokenList(ctx context.Context, in *AdminTokenListRequest, opts ...grpc.CallOption) (*AdminTokenListReply, error)
+	AdminTokenSave(ctx context.Context, in *AdminTokenSaveRequest, opts ...grpc.CallOption) (*AdminTokenSaveReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error)
 	AdminDailyFee(ctx context.Context, in *AdminDailyFeeRequest, opts ...grpc.CallOption) (*AdminDailyFeeReply, error)
@@ -77,6 +105,24 @@ func NewAppClient(cc grpc.ClientConnInterface) AppClient {
 	return &appClient{cc}
 }
 
+func (c *appClient) EthAuthorizeNonce(ctx context.Context, in *EthAuthorizeNonceRequest, opts ...grpc.CallOption) (*EthAuthorizeNonceReply, error) {
+	out := new(EthAuthorizeNonceReply)
+	err := c.cc.Invoke(ctx, "/api.App/EthAuthorizeNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...grpc.CallOption) (*EthAuthorizeReply, error) {
+	out := new(EthAuthorizeReply)
+	err := c.cc.Invoke(ctx, "/api.App/EthAuthorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error) {
 	out := new(UserInfoReply)
 	err := c.cc.Invoke(ctx, "/api.App/UserInfo", in, out, opts...)
@@ -140,6 +186,24 @@ func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) ProductList(ctx context.Context, in *ProductListRequest, opts ...grpc.CallOption) (*ProductListReply, error) {
+	out := new(ProductListReply)
+	err := c.cc.Invoke(ctx, "/api.App/ProductList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DepositProduct(ctx context.Context, in *DepositProductRequest, opts ...grpc.CallOption) (*DepositProductReply, error) {
+	out := new(DepositProductReply)
+	err := c.cc.Invoke(ctx, "/api.App/DepositProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, "/api.App/Deposit", in, out, opts...)
@@ -248,6 +312,159 @@ func (c *appClient) AdminWithdrawPass(ctx context.Context, in *AdminWithdrawPass
 	return out, nil
 }
 
+func (c *appClient) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error) {
+	out := new(AdminWithdrawRejectReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...grpc.CallOption) (*AdminWithdrawRefundReply, error) {
+	out := new(AdminWithdrawRefundReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawTrack(ctx context.Context, in *AdminWithdrawTrackRequest, opts ...grpc.CallOption) (*AdminWithdrawTrackReply, error) {
+	out := new(AdminWithdrawTrackReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWalletTxCheck(ctx context.Context, in *AdminWalletTxCheckRequest, opts ...grpc.CallOption) (*AdminWalletTxCheckReply, error) {
+	out := new(AdminWalletTxCheckReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWalletTxCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSweep(ctx context.Context, in *AdminSweepRequest, opts ...grpc.CallOption) (*AdminSweepReply, error) {
+	out := new(AdminSweepReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositReorgCheck(ctx context.Context, in *AdminDepositReorgCheckRequest, opts ...grpc.CallOption) (*AdminDepositReorgCheckReply, error) {
+	out := new(AdminDepositReorgCheckReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositReorgCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...grpc.CallOption) (*AdminDepositReorgListReply, error) {
+	out := new(AdminDepositReorgListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositReorgList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositReorgResolve(ctx context.Context, in *AdminDepositReorgResolveRequest, opts ...grpc.CallOption) (*AdminDepositReorgResolveReply, error) {
+	out := new(AdminDepositReorgResolveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositReorgResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositLookup(ctx context.Context, in *AdminDepositLookupRequest, opts ...grpc.CallOption) (*AdminDepositLookupReply, error) {
+	out := new(AdminDepositLookupReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositLookup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositCredit(ctx context.Context, in *AdminDepositCreditRequest, opts ...grpc.CallOption) (*AdminDepositCreditReply, error) {
+	out := new(AdminDepositCreditReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminInboundTransferList(ctx context.Context, in *AdminInboundTransferListRequest, opts ...grpc.CallOption) (*AdminInboundTransferListReply, error) {
+	out := new(AdminInboundTransferListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminInboundTransferList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminInboundTransferResolve(ctx context.Context, in *AdminInboundTransferResolveRequest, opts ...grpc.CallOption) (*AdminInboundTransferResolveReply, error) {
+	out := new(AdminInboundTransferResolveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminInboundTransferResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWalletTxReplace(ctx context.Context, in *AdminWalletTxReplaceRequest, opts ...grpc.CallOption) (*AdminWalletTxReplaceReply, error) {
+	out := new(AdminWalletTxReplaceReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWalletTxReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminProductList(ctx context.Context, in *AdminProductListRequest, opts ...grpc.CallOption) (*AdminProductListReply, error) {
+	out := new(AdminProductListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminProductList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminProductSave(ctx context.Context, in *AdminProductSaveRequest, opts ...grpc.CallOption) (*AdminProductSaveReply, error) {
+	out := new(AdminProductSaveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminProductSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminTokenList(ctx context.Context, in *AdminTokenListRequest, opts ...grpc.CallOption) (*AdminTokenListReply, error) {
+	out := new(AdminTokenListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminTokenList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminTokenSave(ctx context.Context, in *AdminTokenSaveRequest, opts ...grpc.CallOption) (*AdminTokenSaveReply, error) {
+	out := new(AdminTokenSaveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminTokenSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error) {
 	out := new(AdminWithdrawEthReply)
 	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawEth", in, out, opts...)
@@ -486,6 +703,8 @@ func (c *appClient) AdminDailyLocationReward(ctx context.Context, in *AdminDaily
 // All implementations must embed UnimplementedAppServer
 // for forward compatibility
 type AppServer interface {
+	EthAuthorizeNonce(context.Context, *EthAuthorizeNonceRequest) (*EthAuthorizeNonceReply, error)
+	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
@@ -493,6 +712,9 @@ type AppServer interface {
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	ProductList(context.Context, *ProductListRequest) (*ProductListReply, error)
+	// 选择下一笔充值的套餐
+	DepositProduct(context.Context, *DepositProductRequest) (*DepositProductReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit2(context.Context, *DepositRequest) (*DepositReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
@@ -505,6 +727,29 @@ type AppServer interface {
 	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawTrack(context.Context, *AdminWithdrawTrackRequest) (*AdminWithdrawTrackReply, error)
+	AdminWalletTxCheck(context.Context, *AdminWalletTxCheckRequest) (*AdminWalletTxCheckReply, error)
+	// 充值地址归集
+	AdminSweep(context.Context, *AdminSweepRequest) (*AdminSweepReply, error)
+	// 已入账充值的区块回滚检查
+	AdminDepositReorgCheck(context.Context, *AdminDepositReorgCheckRequest) (*AdminDepositReorgCheckReply, error)
+	AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error)
+	// 回滚的充值人工冲正后标记已处理
+	AdminDepositReorgResolve(context.Context, *AdminDepositReorgResolveRequest) (*AdminDepositReorgResolveReply, error)
+	// 按交易hash查充值的入账、仓位、余额记录和产生的收益
+	AdminDepositLookup(context.Context, *AdminDepositLookupRequest) (*AdminDepositLookupReply, error)
+	// 扫描漏掉的充值按交易hash从链上补入账，与自动入账流程一致
+	AdminDepositCredit(context.Context, *AdminDepositCreditRequest) (*AdminDepositCreditReply, error)
+	AdminInboundTransferList(context.Context, *AdminInboundTransferListRequest) (*AdminInboundTransferListReply, error)
+	// 未入账的转入人工处理
+	AdminInboundTransferResolve(context.Context, *AdminInboundTransferResolveRequest) (*AdminInboundTransferResolveReply, error)
+	AdminWalletTxReplace(context.Context, *AdminWalletTxReplaceRequest) (*AdminWalletTxReplaceReply, error)
+	AdminProductList(context.Context, *AdminProductListRequest) (*AdminProductListReply, error)
+	AdminProductSave(context.Context, *AdminProductSaveRequest) (*AdminProductSaveReply, error)
+	AdminTokenList(context.Context, *AdminTokenListRequest) (*AdminTokenListReply, error)
+	AdminTokenSave(context.Context, *AdminTokenSaveRequest) (*AdminTokenSaveReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
 	AdminDailyFee(context.Context, *AdminDailyFeeRequest) (*AdminDailyFeeReply, error)
@@ -538,6 +783,12 @@ type AppServer interface {
 type UnimplementedAppServer struct {
 }
 
+func (UnimplementedAppServer) EthAuthorizeNonce(context.Context, *EthAuthorizeNonceRequest) (*EthAuthorizeNonceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthAuthorizeNonce not implemented")
+}
+func (UnimplementedAppServer) EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthAuthorize not implemented")
+}
 func (UnimplementedAppServer) UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
 }
@@ -559,6 +810,12 @@ func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListReque
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedAppServer) ProductList(context.Context, *ProductListRequest) (*ProductListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProductList not implemented")
+}
+func (UnimplementedAppServer) DepositProduct(context.Context, *DepositProductRequest) (*DepositProductReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositProduct not implemented")
+}
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -595,6 +852,57 @@ func (UnimplementedAppServer) AdminWithdraw(context.Context, *AdminWithdrawReque
 func (UnimplementedAppServer) AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawPass not implemented")
 }
+func (UnimplementedAppServer) AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawRefund not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawTrack(context.Context, *AdminWithdrawTrackRequest) (*AdminWithdrawTrackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawTrack not implemented")
+}
+func (UnimplementedAppServer) AdminWalletTxCheck(context.Context, *AdminWalletTxCheckRequest) (*AdminWalletTxCheckReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWalletTxCheck not implemented")
+}
+func (UnimplementedAppServer) AdminSweep(context.Context, *AdminSweepRequest) (*AdminSweepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSweep not implemented")
+}
+func (UnimplementedAppServer) AdminDepositReorgCheck(context.Context, *AdminDepositReorgCheckRequest) (*AdminDepositReorgCheckReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReorgCheck not implemented")
+}
+func (UnimplementedAppServer) AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReorgList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositReorgResolve(context.Context, *AdminDepositReorgResolveRequest) (*AdminDepositReorgResolveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReorgResolve not implemented")
+}
+func (UnimplementedAppServer) AdminDepositLookup(context.Context, *AdminDepositLookupRequest) (*AdminDepositLookupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositLookup not implemented")
+}
+func (UnimplementedAppServer) AdminDepositCredit(context.Context, *AdminDepositCreditRequest) (*AdminDepositCreditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositCredit not implemented")
+}
+func (UnimplementedAppServer) AdminInboundTransferList(context.Context, *AdminInboundTransferListRequest) (*AdminInboundTransferListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminInboundTransferList not implemented")
+}
+func (UnimplementedAppServer) AdminInboundTransferResolve(context.Context, *AdminInboundTransferResolveRequest) (*AdminInboundTransferResolveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminInboundTransferResolve not implemented")
+}
+func (UnimplementedAppServer) AdminWalletTxReplace(context.Context, *AdminWalletTxReplaceRequest) (*AdminWalletTxReplaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWalletTxReplace not implemented")
+}
+func (UnimplementedAppServer) AdminProductList(context.Context, *AdminProductListRequest) (*AdminProductListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProductList not implemented")
+}
+func (UnimplementedAppServer) AdminProductSave(context.Context, *AdminProductSaveRequest) (*AdminProductSaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProductSave not implemented")
+}
+func (UnimplementedAppServer) AdminTokenList(context.Context, *AdminTokenListRequest) (*AdminTokenListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminTokenList not implemented")
+}
+func (UnimplementedAppServer) AdminTokenSave(context.Context, *AdminTokenSaveRequest) (*AdminTokenSaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminTokenSave not implemented")
+}
 func (UnimplementedAppServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
 }
@@ -686,6 +994,42 @@ func RegisterAppServer(s grpc.ServiceRegistrar, srv AppServer) {
 	s.RegisterService(&App_ServiceDesc, srv)
 }
 
+func _App_EthAuthorizeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthAuthorizeNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).EthAuthorizeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/EthAuthorizeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).EthAuthorizeNonce(ctx, req.(*EthAuthorizeNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_EthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthAuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).EthAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/EthAuthorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).EthAuthorize(ctx, req.(*EthAuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserInfoRequest)
 	if err := dec(in); err != nil {
@@ -812,6 +1156,42 @@ func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_ProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ProductList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/ProductList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ProductList(ctx, req.(*ProductListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DepositProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DepositProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositProduct(ctx, req.(*DepositProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -1028,6 +1408,312 @@ func _App_AdminWithdrawPass_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminWithdrawReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawRefund(ctx, req.(*AdminWithdrawRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawTrack(ctx, req.(*AdminWithdrawTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWalletTxCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWalletTxCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWalletTxCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWalletTxCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWalletTxCheck(ctx, req.(*AdminWalletTxCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSweep(ctx, req.(*AdminSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositReorgCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReorgCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositReorgCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositReorgCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositReorgCheck(ctx, req.(*AdminDepositReorgCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositReorgList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReorgListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositReorgList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositReorgList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositReorgList(ctx, req.(*AdminDepositReorgListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositReorgResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReorgResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositReorgResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositReorgResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositReorgResolve(ctx, req.(*AdminDepositReorgResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositLookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositLookup(ctx, req.(*AdminDepositLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositCredit(ctx, req.(*AdminDepositCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminInboundTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminInboundTransferListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminInboundTransferList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminInboundTransferList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminInboundTransferList(ctx, req.(*AdminInboundTransferListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminInboundTransferResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminInboundTransferResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminInboundTransferResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminInboundTransferResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminInboundTransferResolve(ctx, req.(*AdminInboundTransferResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWalletTxReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWalletTxReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWalletTxReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWalletTxReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWalletTxReplace(ctx, req.(*AdminWalletTxReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProductListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminProductList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminProductList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminProductList(ctx, req.(*AdminProductListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminProductSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProductSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminProductSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminProductSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminProductSave(ctx, req.(*AdminProductSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTokenListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminTokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminTokenList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminTokenList(ctx, req.(*AdminTokenListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminTokenSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTokenSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminTokenSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminTokenSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminTokenSave(ctx, req.(*AdminTokenSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminWithdrawEthRequest)
 	if err := dec(in); err != nil {
@@ -1503,6 +2189,14 @@ var App_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.App",
 	HandlerType: (*AppServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthAuthorizeNonce",
+			Handler:    _App_EthAuthorizeNonce_Handler,
+		},
+		{
+			MethodName: "EthAuthorize",
+			Handler:    _App_EthAuthorize_Handler,
+		},
 		{
 			MethodName: "UserInfo",
 			Handler:    _App_UserInfo_Handler,
@@ -1531,6 +2225,14 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _App_Withdraw_Handler,
 		},
+		{
+			MethodName: "ProductList",
+			Handler:    _App_ProductList_Handler,
+		},
+		{
+			MethodName: "DepositProduct",
+			Handler:    _App_DepositProduct_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _App_Deposit_Handler,
@@ -1579,6 +2281,74 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminWithdrawPass",
 			Handler:    _App_AdminWithdrawPass_Handler,
 		},
+		{
+			MethodName: "AdminWithdrawReject",
+			Handler:    _App_AdminWithdrawReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawRefund",
+			Handler:    _App_AdminWithdrawRefund_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawTrack",
+			Handler:    _App_AdminWithdrawTrack_Handler,
+		},
+		{
+			MethodName: "AdminWalletTxCheck",
+			Handler:    _App_AdminWalletTxCheck_Handler,
+		},
+		{
+			MethodName: "AdminSweep",
+			Handler:    _App_AdminSweep_Handler,
+		},
+		{
+			MethodName: "AdminDepositReorgCheck",
+			Handler:    _App_AdminDepositReorgCheck_Handler,
+		},
+		{
+			MethodName: "AdminDepositReorgList",
+			Handler:    _App_AdminDepositReorgList_Handler,
+		},
+		{
+			MethodName: "AdminDepositReorgResolve",
+			Handler:    _App_AdminDepositReorgResolve_Handler,
+		},
+		{
+			MethodName: "AdminDepositLookup",
+			Handler:    _App_AdminDepositLookup_Handler,
+		},
+		{
+			MethodName: "AdminDepositCredit",
+			Handler:    _App_AdminDepositCredit_Handler,
+		},
+		{
+			MethodName: "AdminInboundTransferList",
+			Handler:    _App_AdminInboundTransferList_Handler,
+		},
+		{
+			MethodName: "AdminInboundTransferResolve",
+			Handler:    _App_AdminInboundTransferResolve_Handler,
+		},
+		{
+			MethodName: "AdminWalletTxReplace",
+			Handler:    _App_AdminWalletTxReplace_Handler,
+		},
+		{
+			MethodName: "AdminProductList",
+			Handler:    _App_AdminProductList_Handler,
+		},
+		{
+			MethodName: "AdminProductSave",
+			Handler:    _App_AdminProductSave_Handler,
+		},
+		{
+			MethodName: "AdminTokenList",
+			Handler:    _App_AdminTokenList_Handler,
+		},
+		{
+			MethodName: "AdminTokenSave",
+			Handler:    _App_AdminTokenSave_Handler,
+		},
 		{
 			MethodName: "AdminWithdrawEth",
 			Handler:    _App_AdminWithdrawEth_Handler,
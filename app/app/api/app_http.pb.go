@@ -30,22 +30,39 @@ const OperationAppAdminDailyBalanceReward = "/api.App/AdminDailyBalanceReward"
 const OperationAppAdminDailyFee = "/api.App/AdminDailyFee"
 const OperationAppAdminDailyLocationReward = "/api.App/AdminDailyLocationReward"
 const OperationAppAdminDailyRecommendReward = "/api.App/AdminDailyRecommendReward"
+const OperationAppAdminDepositCredit = "/api.App/AdminDepositCredit"
+const OperationAppAdminDepositLookup = "/api.App/AdminDepositLookup"
+const OperationAppAdminDepositReorgCheck = "/api.App/AdminDepositReorgCheck"
+const OperationAppAdminDepositReorgList = "/api.App/AdminDepositReorgList"
+const OperationAppAdminDepositReorgResolve = "/api.App/AdminDepositReorgResolve"
 const OperationAppAdminFee = "/api.App/AdminFee"
+const OperationAppAdminInboundTransferList = "/api.App/AdminInboundTransferList"
+const OperationAppAdminInboundTransferResolve = "/api.App/AdminInboundTransferResolve"
 const OperationAppAdminList = "/api.App/AdminList"
 const OperationAppAdminLocationAllList = "/api.App/AdminLocationAllList"
 const OperationAppAdminLocationInsert = "/api.App/AdminLocationInsert"
 const OperationAppAdminLocationList = "/api.App/AdminLocationList"
 const OperationAppAdminLogin = "/api.App/AdminLogin"
 const OperationAppAdminMonthRecommend = "/api.App/AdminMonthRecommend"
+const OperationAppAdminProductList = "/api.App/AdminProductList"
+const OperationAppAdminProductSave = "/api.App/AdminProductSave"
 const OperationAppAdminRewardList = "/api.App/AdminRewardList"
+const OperationAppAdminSweep = "/api.App/AdminSweep"
+const OperationAppAdminTokenList = "/api.App/AdminTokenList"
+const OperationAppAdminTokenSave = "/api.App/AdminTokenSave"
 const OperationAppAdminUndoUpdate = "/api.App/AdminUndoUpdate"
 const OperationAppAdminUserList = "/api.App/AdminUserList"
 const OperationAppAdminUserRecommend = "/api.App/AdminUserRecommend"
 const OperationAppAdminVipUpdate = "/api.App/AdminVipUpdate"
+const OperationAppAdminWalletTxCheck = "/api.App/AdminWalletTxCheck"
+const OperationAppAdminWalletTxReplace = "/api.App/AdminWalletTxReplace"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
 const OperationAppAdminWithdrawList = "/api.App/AdminWithdrawList"
 const OperationAppAdminWithdrawPass = "/api.App/AdminWithdrawPass"
+const OperationAppAdminWithdrawRefund = "/api.App/AdminWithdrawRefund"
+const OperationAppAdminWithdrawReject = "/api.App/AdminWithdrawReject"
+const OperationAppAdminWithdrawTrack = "/api.App/AdminWithdrawTrack"
 const OperationAppAuthAdminCreate = "/api.App/AuthAdminCreate"
 const OperationAppAuthAdminDelete = "/api.App/AuthAdminDelete"
 const OperationAppAuthList = "/api.App/AuthList"
@@ -54,9 +71,13 @@ const OperationAppCheckAndInsertLocationsRecommendUser = "/api.App/CheckAndInser
 const OperationAppCheckAndInsertRecommendArea = "/api.App/CheckAndInsertRecommendArea"
 const OperationAppDeposit = "/api.App/Deposit"
 const OperationAppDeposit2 = "/api.App/Deposit2"
+const OperationAppDepositProduct = "/api.App/DepositProduct"
+const OperationAppEthAuthorize = "/api.App/EthAuthorize"
+const OperationAppEthAuthorizeNonce = "/api.App/EthAuthorizeNonce"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
 const OperationAppLockSystem = "/api.App/LockSystem"
 const OperationAppMyAuthList = "/api.App/MyAuthList"
+const OperationAppProductList = "/api.App/ProductList"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
 const OperationAppRewardList = "/api.App/RewardList"
@@ -77,22 +98,39 @@ type AppHTTPServer interface {
 	AdminDailyFee(context.Context, *AdminDailyFeeRequest) (*AdminDailyFeeReply, error)
 	AdminDailyLocationReward(context.Context, *AdminDailyLocationRewardRequest) (*AdminDailyLocationRewardReply, error)
 	AdminDailyRecommendReward(context.Context, *AdminDailyRecommendRewardRequest) (*AdminDailyRecommendRewardReply, error)
+	AdminDepositCredit(context.Context, *AdminDepositCreditRequest) (*AdminDepositCreditReply, error)
+	AdminDepositLookup(context.Context, *AdminDepositLookupRequest) (*AdminDepositLookupReply, error)
+	AdminDepositReorgCheck(context.Context, *AdminDepositReorgCheckRequest) (*AdminDepositReorgCheckReply, error)
+	AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error)
+	AdminDepositReorgResolve(context.Context, *AdminDepositReorgResolveRequest) (*AdminDepositReorgResolveReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminInboundTransferList(context.Context, *AdminInboundTransferListRequest) (*AdminInboundTransferListReply, error)
+	AdminInboundTransferResolve(context.Context, *AdminInboundTransferResolveRequest) (*AdminInboundTransferResolveReply, error)
 	AdminList(context.Context, *AdminListRequest) (*AdminListReply, error)
 	AdminLocationAllList(context.Context, *AdminLocationAllListRequest) (*AdminLocationAllListReply, error)
 	AdminLocationInsert(context.Context, *AdminLocationInsertRequest) (*AdminLocationInsertReply, error)
 	AdminLocationList(context.Context, *AdminLocationListRequest) (*AdminLocationListReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminMonthRecommend(context.Context, *AdminMonthRecommendRequest) (*AdminMonthRecommendReply, error)
+	AdminProductList(context.Context, *AdminProductListRequest) (*AdminProductListReply, error)
+	AdminProductSave(context.Context, *AdminProductSaveRequest) (*AdminProductSaveReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	AdminSweep(context.Context, *AdminSweepRequest) (*AdminSweepReply, error)
+	AdminTokenList(context.Context, *AdminTokenListRequest) (*AdminTokenListReply, error)
+	AdminTokenSave(context.Context, *AdminTokenSaveRequest) (*AdminTokenSaveReply, error)
 	AdminUndoUpdate(context.Context, *AdminUndoUpdateRequest) (*AdminUndoUpdateReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
 	AdminUserRecommend(context.Context, *AdminUserRecommendRequest) (*AdminUserRecommendReply, error)
 	AdminVipUpdate(context.Context, *AdminVipUpdateRequest) (*AdminVipUpdateReply, error)
+	AdminWalletTxCheck(context.Context, *AdminWalletTxCheckRequest) (*AdminWalletTxCheckReply, error)
+	AdminWalletTxReplace(context.Context, *AdminWalletTxReplaceRequest) (*AdminWalletTxReplaceReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
 	AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error)
+	AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawTrack(context.Context, *AdminWithdrawTrackRequest) (*AdminWithdrawTrackReply, error)
 	AuthAdminCreate(context.Context, *AuthAdminCreateRequest) (*AuthAdminCreateReply, error)
 	AuthAdminDelete(context.Context, *AuthAdminDeleteRequest) (*AuthAdminDeleteReply, error)
 	AuthList(context.Context, *AuthListRequest) (*AuthListReply, error)
@@ -101,9 +139,13 @@ type AppHTTPServer interface {
 	CheckAndInsertRecommendArea(context.Context, *CheckAndInsertRecommendAreaRequest) (*CheckAndInsertRecommendAreaReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit2(context.Context, *DepositRequest) (*DepositReply, error)
+	DepositProduct(context.Context, *DepositProductRequest) (*DepositProductReply, error)
+	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
+	EthAuthorizeNonce(context.Context, *EthAuthorizeNonceRequest) (*EthAuthorizeNonceReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	LockSystem(context.Context, *LockSystemRequest) (*LockSystemReply, error)
 	MyAuthList(context.Context, *MyAuthListRequest) (*MyAuthListReply, error)
+	ProductList(context.Context, *ProductListRequest) (*ProductListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
@@ -115,6 +157,8 @@ type AppHTTPServer interface {
 
 func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r := s.Route("/")
+	r.GET("/api/app_server/eth_authorize_nonce", _App_EthAuthorizeNonce0_HTTP_Handler(srv))
+	r.POST("/api/app_server/eth_authorize", _App_EthAuthorize0_HTTP_Handler(srv))
 	r.GET("/api/app_server/user_info", _App_UserInfo0_HTTP_Handler(srv))
 	r.GET("/api/app_server/reward_list", _App_RewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_reward_list", _App_RecommendRewardList0_HTTP_Handler(srv))
@@ -122,6 +166,8 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
+	r.GET("/api/app_server/product_list", _App_ProductList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/deposit_product", _App_DepositProduct0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit_2", _App_Deposit20_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_list", _App_AdminRewardList0_HTTP_Handler(srv))
@@ -134,6 +180,23 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/withdraw_list", _App_AdminWithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/withdraw_pass", _App_AdminWithdrawPass0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_reject", _App_AdminWithdrawReject0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_refund", _App_AdminWithdrawRefund0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_track", _App_AdminWithdrawTrack0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/wallet_tx_check", _App_AdminWalletTxCheck0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/sweep", _App_AdminSweep0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_reorg_check", _App_AdminDepositReorgCheck0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_reorg_list", _App_AdminDepositReorgList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_reorg_resolve", _App_AdminDepositReorgResolve0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_lookup", _App_AdminDepositLookup0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_credit", _App_AdminDepositCredit0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/inbound_transfer_list", _App_AdminInboundTransferList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/inbound_transfer_resolve", _App_AdminInboundTransferResolve0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/wallet_tx_replace", _App_AdminWalletTxReplace0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/product_list", _App_AdminProductList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/product_save", _App_AdminProductSave0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/token_list", _App_AdminTokenList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/token_save", _App_AdminTokenSave0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/daily_fee", _App_AdminDailyFee0_HTTP_Handler(srv))
@@ -162,6 +225,47 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/daily_location_reward", _App_AdminDailyLocationReward0_HTTP_Handler(srv))
 }
 
+func _App_EthAuthorizeNonce0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EthAuthorizeNonceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppEthAuthorizeNonce)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EthAuthorizeNonce(ctx, req.(*EthAuthorizeNonceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EthAuthorizeNonceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_EthAuthorize0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EthAuthorizeRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppEthAuthorize)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EthAuthorize(ctx, req.(*EthAuthorizeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EthAuthorizeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_UserInfo0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UserInfoRequest
@@ -298,6 +402,47 @@ func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_ProductList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ProductListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppProductList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ProductList(ctx, req.(*ProductListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_DepositProduct0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositProductRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositProduct(ctx, req.(*DepositProductRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositProductReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DepositRequest
@@ -325,206 +470,553 @@ func _App_Deposit20_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 		}
 		http.SetOperation(ctx, OperationAppDeposit2)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Deposit2(ctx, req.(*DepositRequest))
+			return srv.Deposit2(ctx, req.(*DepositRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRewardList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRewardListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRewardList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRewardList(ctx, req.(*AdminRewardListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRewardListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_LockSystem0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LockSystemRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLockSystem)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LockSystem(ctx, req.(*LockSystemRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LockSystemReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserList(ctx, req.(*AdminUserListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_CheckAdminUserArea0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAdminUserAreaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppCheckAdminUserArea)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAdminUserArea(ctx, req.(*CheckAdminUserAreaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAdminUserAreaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_CheckAndInsertLocationsRecommendUser0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAndInsertLocationsRecommendUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppCheckAndInsertLocationsRecommendUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAndInsertLocationsRecommendUser(ctx, req.(*CheckAndInsertLocationsRecommendUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAndInsertLocationsRecommendUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationList(ctx, req.(*AdminLocationListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationAllList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationAllListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationAllList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationAllList(ctx, req.(*AdminLocationAllListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationAllListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdraw)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawPass0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawPassRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawPass)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawPass(ctx, req.(*AdminWithdrawPassRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawPassReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRejectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawRefund0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRefundRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawRefund)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawRefund(ctx, req.(*AdminWithdrawRefundRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRefundReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawTrack0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawTrackRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawTrack)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawTrack(ctx, req.(*AdminWithdrawTrackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawTrackReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWalletTxCheck0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWalletTxCheckRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWalletTxCheck)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWalletTxCheck(ctx, req.(*AdminWalletTxCheckRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWalletTxCheckReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSweep0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSweepRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSweep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSweep(ctx, req.(*AdminSweepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSweepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositReorgCheck0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReorgCheckRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositReorgCheck)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReorgCheck(ctx, req.(*AdminDepositReorgCheckRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositReorgCheckReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositReorgList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReorgListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositReorgList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReorgList(ctx, req.(*AdminDepositReorgListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DepositReply)
+		reply := out.(*AdminDepositReorgListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminRewardList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminDepositReorgResolve0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminRewardListRequest
+		var in AdminDepositReorgResolveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminRewardList)
+		http.SetOperation(ctx, OperationAppAdminDepositReorgResolve)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminRewardList(ctx, req.(*AdminRewardListRequest))
+			return srv.AdminDepositReorgResolve(ctx, req.(*AdminDepositReorgResolveRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminRewardListReply)
+		reply := out.(*AdminDepositReorgResolveReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_LockSystem0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminDepositLookup0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LockSystemRequest
+		var in AdminDepositLookupRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppLockSystem)
+		http.SetOperation(ctx, OperationAppAdminDepositLookup)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LockSystem(ctx, req.(*LockSystemRequest))
+			return srv.AdminDepositLookup(ctx, req.(*AdminDepositLookupRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LockSystemReply)
+		reply := out.(*AdminDepositLookupReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminUserList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminDepositCredit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminUserListRequest
+		var in AdminDepositCreditRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminUserList)
+		http.SetOperation(ctx, OperationAppAdminDepositCredit)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminUserList(ctx, req.(*AdminUserListRequest))
+			return srv.AdminDepositCredit(ctx, req.(*AdminDepositCreditRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminUserListReply)
+		reply := out.(*AdminDepositCreditReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_CheckAdminUserArea0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminInboundTransferList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CheckAdminUserAreaRequest
+		var in AdminInboundTransferListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppCheckAdminUserArea)
+		http.SetOperation(ctx, OperationAppAdminInboundTransferList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CheckAdminUserArea(ctx, req.(*CheckAdminUserAreaRequest))
+			return srv.AdminInboundTransferList(ctx, req.(*AdminInboundTransferListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CheckAdminUserAreaReply)
+		reply := out.(*AdminInboundTransferListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_CheckAndInsertLocationsRecommendUser0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminInboundTransferResolve0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CheckAndInsertLocationsRecommendUserRequest
+		var in AdminInboundTransferResolveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppCheckAndInsertLocationsRecommendUser)
+		http.SetOperation(ctx, OperationAppAdminInboundTransferResolve)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CheckAndInsertLocationsRecommendUser(ctx, req.(*CheckAndInsertLocationsRecommendUserRequest))
+			return srv.AdminInboundTransferResolve(ctx, req.(*AdminInboundTransferResolveRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CheckAndInsertLocationsRecommendUserReply)
+		reply := out.(*AdminInboundTransferResolveReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminLocationList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminWalletTxReplace0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminLocationListRequest
+		var in AdminWalletTxReplaceRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminLocationList)
+		http.SetOperation(ctx, OperationAppAdminWalletTxReplace)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminLocationList(ctx, req.(*AdminLocationListRequest))
+			return srv.AdminWalletTxReplace(ctx, req.(*AdminWalletTxReplaceRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminLocationListReply)
+		reply := out.(*AdminWalletTxReplaceReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminLocationAllList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminProductList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminLocationAllListRequest
+		var in AdminProductListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminLocationAllList)
+		http.SetOperation(ctx, OperationAppAdminProductList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminLocationAllList(ctx, req.(*AdminLocationAllListRequest))
+			return srv.AdminProductList(ctx, req.(*AdminProductListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminLocationAllListReply)
+		reply := out.(*AdminProductListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminWithdrawList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminProductSave0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminWithdrawListRequest
+		var in AdminProductSaveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminWithdrawList)
+		http.SetOperation(ctx, OperationAppAdminProductSave)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+			return srv.AdminProductSave(ctx, req.(*AdminProductSaveRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminWithdrawListReply)
+		reply := out.(*AdminProductSaveReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminTokenList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminWithdrawRequest
+		var in AdminTokenListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminWithdraw)
+		http.SetOperation(ctx, OperationAppAdminTokenList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+			return srv.AdminTokenList(ctx, req.(*AdminTokenListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminWithdrawReply)
+		reply := out.(*AdminTokenListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminWithdrawPass0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminTokenSave0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminWithdrawPassRequest
+		var in AdminTokenSaveRequest
 		if err := ctx.Bind(&in.SendBody); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminWithdrawPass)
+		http.SetOperation(ctx, OperationAppAdminTokenSave)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminWithdrawPass(ctx, req.(*AdminWithdrawPassRequest))
+			return srv.AdminTokenSave(ctx, req.(*AdminTokenSaveRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminWithdrawPassReply)
+		reply := out.(*AdminTokenSaveReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -1068,22 +1560,39 @@ type AppHTTPClient interface {
 	AdminDailyFee(ctx context.Context, req *AdminDailyFeeRequest, opts ...http.CallOption) (rsp *AdminDailyFeeReply, err error)
 	AdminDailyLocationReward(ctx context.Context, req *AdminDailyLocationRewardRequest, opts ...http.CallOption) (rsp *AdminDailyLocationRewardReply, err error)
 	AdminDailyRecommendReward(ctx context.Context, req *AdminDailyRecommendRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendRewardReply, err error)
+	AdminDepositCredit(ctx context.Context, req *AdminDepositCreditRequest, opts ...http.CallOption) (rsp *AdminDepositCreditReply, err error)
+	AdminDepositLookup(ctx context.Context, req *AdminDepositLookupRequest, opts ...http.CallOption) (rsp *AdminDepositLookupReply, err error)
+	AdminDepositReorgCheck(ctx context.Context, req *AdminDepositReorgCheckRequest, opts ...http.CallOption) (rsp *AdminDepositReorgCheckReply, err error)
+	AdminDepositReorgList(ctx context.Context, req *AdminDepositReorgListRequest, opts ...http.CallOption) (rsp *AdminDepositReorgListReply, err error)
+	AdminDepositReorgResolve(ctx context.Context, req *AdminDepositReorgResolveRequest, opts ...http.CallOption) (rsp *AdminDepositReorgResolveReply, err error)
 	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminInboundTransferList(ctx context.Context, req *AdminInboundTransferListRequest, opts ...http.CallOption) (rsp *AdminInboundTransferListReply, err error)
+	AdminInboundTransferResolve(ctx context.Context, req *AdminInboundTransferResolveRequest, opts ...http.CallOption) (rsp *AdminInboundTransferResolveReply, err error)
 	AdminList(ctx context.Context, req *AdminListRequest, opts ...http.CallOption) (rsp *AdminListReply, err error)
 	AdminLocationAllList(ctx context.Context, req *AdminLocationAllListRequest, opts ...http.CallOption) (rsp *AdminLocationAllListReply, err error)
 	AdminLocationInsert(ctx context.Context, req *AdminLocationInsertRequest, opts ...http.CallOption) (rsp *AdminLocationInsertReply, err error)
 	AdminLocationList(ctx context.Context, req *AdminLocationListRequest, opts ...http.CallOption) (rsp *AdminLocationListReply, err error)
 	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
 	AdminMonthRecommend(ctx context.Context, req *AdminMonthRecommendRequest, opts ...http.CallOption) (rsp *AdminMonthRecommendReply, err error)
+	AdminProductList(ctx context.Context, req *AdminProductListRequest, opts ...http.CallOption) (rsp *AdminProductListReply, err error)
+	AdminProductSave(ctx context.Context, req *AdminProductSaveRequest, opts ...http.CallOption) (rsp *AdminProductSaveReply, err error)
 	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
+	AdminSweep(ctx context.Context, req *AdminSweepRequest, opts ...http.CallOption) (rsp *AdminSweepReply, err error)
+	AdminTokenList(ctx context.Context, req *AdminTokenListRequest, opts ...http.CallOption) (rsp *AdminTokenListReply, err error)
+	AdminTokenSave(ctx context.Context, req *AdminTokenSaveRequest, opts ...http.CallOption) (rsp *AdminTokenSaveReply, err error)
 	AdminUndoUpdate(ctx context.Context, req *AdminUndoUpdateRequest, opts ...http.CallOption) (rsp *AdminUndoUpdateReply, err error)
 	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
 	AdminUserRecommend(ctx context.Context, req *AdminUserRecommendRequest, opts ...http.CallOption) (rsp *AdminUserRecommendReply, err error)
 	AdminVipUpdate(ctx context.Context, req *AdminVipUpdateRequest, opts ...http.CallOption) (rsp *AdminVipUpdateReply, err error)
+	AdminWalletTxCheck(ctx context.Context, req *AdminWalletTxCheckRequest, opts ...http.CallOption) (rsp *AdminWalletTxCheckReply, err error)
+	AdminWalletTxReplace(ctx context.Context, req *AdminWalletTxReplaceRequest, opts ...http.CallOption) (rsp *AdminWalletTxReplaceReply, err error)
 	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
 	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
 	AdminWithdrawPass(ctx context.Context, req *AdminWithdrawPassRequest, opts ...http.CallOption) (rsp *AdminWithdrawPassReply, err error)
+	AdminWithdrawRefund(ctx context.Context, req *AdminWithdrawRefundRequest, opts ...http.CallOption) (rsp *AdminWithdrawRefundReply, err error)
+	AdminWithdrawReject(ctx context.Context, req *AdminWithdrawRejectRequest, opts ...http.CallOption) (rsp *AdminWithdrawRejectReply, err error)
+	AdminWithdrawTrack(ctx context.Context, req *AdminWithdrawTrackRequest, opts ...http.CallOption) (rsp *AdminWithdrawTrackReply, err error)
 	AuthAdminCreate(ctx context.Context, req *AuthAdminCreateRequest, opts ...http.CallOption) (rsp *AuthAdminCreateReply, err error)
 	AuthAdminDelete(ctx context.Context, req *AuthAdminDeleteRequest, opts ...http.CallOption) (rsp *AuthAdminDeleteReply, err error)
 	AuthList(ctx context.Context, req *AuthListRequest, opts ...http.CallOption) (rsp *AuthListReply, err error)
@@ -1092,9 +1601,13 @@ type AppHTTPClient interface {
 	CheckAndInsertRecommendArea(ctx context.Context, req *CheckAndInsertRecommendAreaRequest, opts ...http.CallOption) (rsp *CheckAndInsertRecommendAreaReply, err error)
 	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
 	Deposit2(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	DepositProduct(ctx context.Context, req *DepositProductRequest, opts ...http.CallOption) (rsp *DepositProductReply, err error)
+	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
+	EthAuthorizeNonce(ctx context.Context, req *EthAuthorizeNonceRequest, opts ...http.CallOption) (rsp *EthAuthorizeNonceReply, err error)
 	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
 	LockSystem(ctx context.Context, req *LockSystemRequest, opts ...http.CallOption) (rsp *LockSystemReply, err error)
 	MyAuthList(ctx context.Context, req *MyAuthListRequest, opts ...http.CallOption) (rsp *MyAuthListReply, err error)
+	ProductList(ctx context.Context, req *ProductListRequest, opts ...http.CallOption) (rsp *ProductListReply, err error)
 	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
 	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
 	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
@@ -1255,6 +1768,71 @@ func (c *AppHTTPClientImpl) AdminDailyRecommendReward(ctx context.Context, in *A
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminDepositCredit(ctx context.Context, in *AdminDepositCreditRequest, opts ...http.CallOption) (*AdminDepositCreditReply, error) {
+	var out AdminDepositCreditReply
+	pattern := "/api/admin_dhb/deposit_credit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositCredit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositLookup(ctx context.Context, in *AdminDepositLookupRequest, opts ...http.CallOption) (*AdminDepositLookupReply, error) {
+	var out AdminDepositLookupReply
+	pattern := "/api/admin_dhb/deposit_lookup"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositLookup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositReorgCheck(ctx context.Context, in *AdminDepositReorgCheckRequest, opts ...http.CallOption) (*AdminDepositReorgCheckReply, error) {
+	var out AdminDepositReorgCheckReply
+	pattern := "/api/admin_dhb/deposit_reorg_check"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositReorgCheck))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...http.CallOption) (*AdminDepositReorgListReply, error) {
+	var out AdminDepositReorgListReply
+	pattern := "/api/admin_dhb/deposit_reorg_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositReorgList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositReorgResolve(ctx context.Context, in *AdminDepositReorgResolveRequest, opts ...http.CallOption) (*AdminDepositReorgResolveReply, error) {
+	var out AdminDepositReorgResolveReply
+	pattern := "/api/admin_dhb/deposit_reorg_resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositReorgResolve))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
 	var out AdminFeeReply
 	pattern := "/api/admin_dhb/fee"
@@ -1268,6 +1846,32 @@ func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminInboundTransferList(ctx context.Context, in *AdminInboundTransferListRequest, opts ...http.CallOption) (*AdminInboundTransferListReply, error) {
+	var out AdminInboundTransferListReply
+	pattern := "/api/admin_dhb/inbound_transfer_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminInboundTransferList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminInboundTransferResolve(ctx context.Context, in *AdminInboundTransferResolveRequest, opts ...http.CallOption) (*AdminInboundTransferResolveReply, error) {
+	var out AdminInboundTransferResolveReply
+	pattern := "/api/admin_dhb/inbound_transfer_resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminInboundTransferResolve))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminList(ctx context.Context, in *AdminListRequest, opts ...http.CallOption) (*AdminListReply, error) {
 	var out AdminListReply
 	pattern := "/api/admin_dhb/admin_list"
@@ -1346,6 +1950,32 @@ func (c *AppHTTPClientImpl) AdminMonthRecommend(ctx context.Context, in *AdminMo
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminProductList(ctx context.Context, in *AdminProductListRequest, opts ...http.CallOption) (*AdminProductListReply, error) {
+	var out AdminProductListReply
+	pattern := "/api/admin_dhb/product_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminProductList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminProductSave(ctx context.Context, in *AdminProductSaveRequest, opts ...http.CallOption) (*AdminProductSaveReply, error) {
+	var out AdminProductSaveReply
+	pattern := "/api/admin_dhb/product_save"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminProductSave))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...http.CallOption) (*AdminRewardListReply, error) {
 	var out AdminRewardListReply
 	pattern := "/api/admin_dhb/reward_list"
@@ -1359,6 +1989,45 @@ func (c *AppHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminReward
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminSweep(ctx context.Context, in *AdminSweepRequest, opts ...http.CallOption) (*AdminSweepReply, error) {
+	var out AdminSweepReply
+	pattern := "/api/admin_dhb/sweep"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminSweep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminTokenList(ctx context.Context, in *AdminTokenListRequest, opts ...http.CallOption) (*AdminTokenListReply, error) {
+	var out AdminTokenListReply
+	pattern := "/api/admin_dhb/token_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminTokenList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminTokenSave(ctx context.Context, in *AdminTokenSaveRequest, opts ...http.CallOption) (*AdminTokenSaveReply, error) {
+	var out AdminTokenSaveReply
+	pattern := "/api/admin_dhb/token_save"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminTokenSave))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminUndoUpdate(ctx context.Context, in *AdminUndoUpdateRequest, opts ...http.CallOption) (*AdminUndoUpdateReply, error) {
 	var out AdminUndoUpdateReply
 	pattern := "/api/admin_dhb/undo_update"
@@ -1411,6 +2080,32 @@ func (c *AppHTTPClientImpl) AdminVipUpdate(ctx context.Context, in *AdminVipUpda
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWalletTxCheck(ctx context.Context, in *AdminWalletTxCheckRequest, opts ...http.CallOption) (*AdminWalletTxCheckReply, error) {
+	var out AdminWalletTxCheckReply
+	pattern := "/api/admin_dhb/wallet_tx_check"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWalletTxCheck))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWalletTxReplace(ctx context.Context, in *AdminWalletTxReplaceRequest, opts ...http.CallOption) (*AdminWalletTxReplaceReply, error) {
+	var out AdminWalletTxReplaceReply
+	pattern := "/api/admin_dhb/wallet_tx_replace"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWalletTxReplace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
 	var out AdminWithdrawReply
 	pattern := "/api/admin_dhb/withdraw"
@@ -1463,6 +2158,45 @@ func (c *AppHTTPClientImpl) AdminWithdrawPass(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...http.CallOption) (*AdminWithdrawRefundReply, error) {
+	var out AdminWithdrawRefundReply
+	pattern := "/api/admin_dhb/withdraw_refund"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawRefund))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...http.CallOption) (*AdminWithdrawRejectReply, error) {
+	var out AdminWithdrawRejectReply
+	pattern := "/api/admin_dhb/withdraw_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawTrack(ctx context.Context, in *AdminWithdrawTrackRequest, opts ...http.CallOption) (*AdminWithdrawTrackReply, error) {
+	var out AdminWithdrawTrackReply
+	pattern := "/api/admin_dhb/withdraw_track"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawTrack))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AuthAdminCreate(ctx context.Context, in *AuthAdminCreateRequest, opts ...http.CallOption) (*AuthAdminCreateReply, error) {
 	var out AuthAdminCreateReply
 	pattern := "/api/admin_dhb/auth_create"
@@ -1567,6 +2301,45 @@ func (c *AppHTTPClientImpl) Deposit2(ctx context.Context, in *DepositRequest, op
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) DepositProduct(ctx context.Context, in *DepositProductRequest, opts ...http.CallOption) (*DepositProductReply, error) {
+	var out DepositProductReply
+	pattern := "/api/app_server/deposit_product"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppDepositProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...http.CallOption) (*EthAuthorizeReply, error) {
+	var out EthAuthorizeReply
+	pattern := "/api/app_server/eth_authorize"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppEthAuthorize))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) EthAuthorizeNonce(ctx context.Context, in *EthAuthorizeNonceRequest, opts ...http.CallOption) (*EthAuthorizeNonceReply, error) {
+	var out EthAuthorizeNonceReply
+	pattern := "/api/app_server/eth_authorize_nonce"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppEthAuthorizeNonce))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...http.CallOption) (*FeeRewardListReply, error) {
 	var out FeeRewardListReply
 	pattern := "/api/app_server/fee_reward_list"
@@ -1606,6 +2379,19 @@ func (c *AppHTTPClientImpl) MyAuthList(ctx context.Context, in *MyAuthListReques
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) ProductList(ctx context.Context, in *ProductListRequest, opts ...http.CallOption) (*ProductListReply, error) {
+	var out ProductListReply
+	pattern := "/api/app_server/product_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppProductList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, deposit *conf.Deposit, job *conf.Job, signer *conf.Signer, chain *conf.Chain, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
@@ -38,13 +38,51 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
+	nonceRepo := data.NewNonceRepo(dataData, logger)
+	withdrawChain, cleanup2, err := data.NewWithdrawChain(chain, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	walletTxRepo := data.NewWalletTxRepo(dataData, logger)
+	tokenRepo := data.NewTokenRepo(dataData, logger)
+	depositAddressRepo := data.NewDepositAddressRepo(dataData, logger)
+	addressDeriver, err := data.NewAddressDeriver(deposit)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sweepRepo := data.NewSweepRepo(dataData, logger)
+	sweepSigner, cleanup3, err := data.NewSweepSigner(signer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sweepConfig := data.NewSweepConfig(chain)
+	bizSigner, cleanup4, err := data.NewSigner(signer, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	productRepo := data.NewProductRepo(dataData, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, nonceRepo, withdrawChain, walletTxRepo, tokenRepo, depositAddressRepo, addressDeriver, sweepRepo, sweepSigner, sweepConfig, bizSigner, productRepo, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
-	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
-	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
-	app := newApp(logger, httpServer)
+	depositSource := data.NewDepositSource(deposit, chain, logger)
+	depositCursorRepo := data.NewDepositCursorRepo(dataData, logger)
+	inboundTransferRepo := data.NewInboundTransferRepo(dataData, logger)
+	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, userRepo, depositSource, depositCursorRepo, tokenRepo, depositAddressRepo, withdrawChain, inboundTransferRepo, productRepo, sweepConfig, transaction, logger)
+	appService := service.NewAppService(userUseCase, recordUseCase, bizSigner, logger, auth)
+	httpServer := server.NewHTTPServer(confServer, auth, appService, logger)
+	jobServer := server.NewJobServer(job, appService, client, logger)
+	app := newApp(logger, httpServer, jobServer)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"math/big"
+	"os"
+
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/pkg/middleware/auth"
+	"dhb/app/app/internal/service"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+// go build -ldflags "-X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name string
+	// Version is the version of the compiled software.
+	Version string
+	// flagconf is the config flag.
+	flagconf string
+
+	id, _ = os.Hostname()
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs/signer", "config path, eg: -conf config.yaml")
+}
+
+// parseAmount 为空时返回nil
+func parseAmount(name string, s string) (*big.Int, error) {
+	if "" == s {
+		return nil, nil
+	}
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok || 0 >= amount.Sign() {
+		return nil, errors.New("invalid " + name + " " + s)
+	}
+	return amount, nil
+}
+
+// newSignPolicy 按配置生成出款规则
+func newSignPolicy(c *conf.SignerDaemon, self common.Address, deriver biz.AddressDeriver, repo biz.SignPolicyRepo) (*biz.SignPolicy, error) {
+	o := &biz.SignPolicyOption{
+		ChainId:           c.ChainId,
+		DailyLimits:       make(map[common.Address]*big.Int, 0),
+		AllowDestinations: make([]common.Address, 0),
+	}
+	for _, v := range c.Tokens {
+		if !common.IsHexAddress(v.Address) {
+			return nil, errors.New("invalid token address " + v.Address)
+		}
+		limit, err := parseAmount("daily limit", v.DailyLimit)
+		if nil != err {
+			return nil, err
+		}
+		if nil == limit { // 不设上限的代币不允许出款
+			return nil, errors.New("daily limit is not set for token " + v.Address)
+		}
+		o.DailyLimits[common.HexToAddress(v.Address)] = limit
+	}
+
+	for _, v := range c.AllowDestinations {
+		if !common.IsHexAddress(v) {
+			return nil, errors.New("invalid allow destination " + v)
+		}
+		o.AllowDestinations = append(o.AllowDestinations, common.HexToAddress(v))
+	}
+
+	var err error
+	if o.GasFundingMax, err = parseAmount("gas funding max", c.GasFundingMax); nil != err {
+		return nil, err
+	}
+	if o.GasFundingDaily, err = parseAmount("gas funding daily limit", c.GasFundingDailyLimit); nil != err {
+		return nil, err
+	}
+
+	if "" != c.CollectionAddress {
+		if !common.IsHexAddress(c.CollectionAddress) {
+			return nil, errors.New("invalid collection address " + c.CollectionAddress)
+		}
+		o.Collection = common.HexToAddress(c.CollectionAddress)
+	}
+
+	return biz.NewSignPolicy(o, self, deriver, repo), nil
+}
+
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", Name,
+		"service.version", Version,
+	)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	if nil == bc.SignerDaemon || nil == bc.SignerDaemon.Signer || "remote" == bc.SignerDaemon.Signer.Type {
+		panic("signer_daemon.signer must be keystore or memory")
+	}
+
+	signer, cleanup, err := data.NewSigner(bc.SignerDaemon.Signer, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	sweepSigner, sweepCleanup, err := data.NewSweepSigner(bc.SignerDaemon.Signer, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer sweepCleanup()
+
+	if nil == bc.SignerDaemon.Database {
+		panic("signer_daemon.database is not set")
+	}
+	db := data.NewDB(&conf.Data{Database: bc.SignerDaemon.Database})
+
+	// 补gas的收款地址由归集私钥派生校验
+	deriver, _ := sweepSigner.(biz.AddressDeriver)
+	policy, err := newSignPolicy(bc.SignerDaemon, signer.Address(), deriver, data.NewSignPolicyRepo(db, logger))
+	if err != nil {
+		panic(err)
+	}
+
+	token := os.Getenv(bc.SignerDaemon.TokenEnv)
+	if "" == token {
+		panic("signer_daemon.token_env is not set")
+	}
+
+	gs := grpc.NewServer(
+		grpc.Address(bc.SignerDaemon.Addr),
+		grpc.Middleware(
+			recovery.Recovery(),
+			auth.SharedTokenServer(token), // 只接受持有共享密钥的调用
+		),
+	)
+	v1.RegisterSignerServer(gs, service.NewSignerService(signer, sweepSigner, policy, logger))
+
+	app := kratos.New(
+		kratos.ID(id),
+		kratos.Name(Name),
+		kratos.Version(Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Logger(logger),
+		kratos.Server(
+			gs,
+		),
+	)
+
+	// start and wait for stop signal
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1032 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DepositTransfer 链上代币转入记录，Value为链上原始精度的十进制字符串
+type DepositTransfer struct {
+	Hash        string
+	From        string
+	To          string
+	Value       string
+	Contract    string
+	BlockNumber int64
+	BlockHash   string
+	LogIndex    int64
+}
+
+// DepositCursor 充值扫描游标，BlockNumber之前的区块都已处理，同一区块内按(hash, log_index)去重
+type DepositCursor struct {
+	ID          int64
+	Name        string
+	BlockNumber int64
+}
+
+// DepositSource 充值数据来源，bscscan、节点rpc或测试用的内存数据
+type DepositSource interface {
+	GetLatestBlockNumber(ctx context.Context) (int64, error)
+	// GetDepositTransfers 获取[fromBlock, toBlock]内转入to中任一地址的代币记录，按区块和log顺序排列
+	GetDepositTransfers(ctx context.Context, contract string, to []string, fromBlock int64, toBlock int64) ([]*DepositTransfer, error)
+	// GetDepositTransfersByHash 成功交易中的全部代币转账
+	GetDepositTransfersByHash(ctx context.Context, hash string) ([]*DepositTransfer, error)
+}
+
+type DepositCursorRepo interface {
+	GetDepositCursor(ctx context.Context, name string) (*DepositCursor, error)
+	UpdateDepositCursor(ctx context.Context, name string, blockNumber int64) error
+}
+
+// DepositAddress 用户专属充值地址，按转入的目标地址认定充值用户
+type DepositAddress struct {
+	ID        int64
+	UserId    int64
+	Address   string
+	PathIndex int64
+	ProductId int64 // 用户选择的套餐，0按金额匹配
+	CreatedAt time.Time
+}
+
+type DepositAddressRepo interface {
+	CreateDepositAddress(ctx context.Context, d *DepositAddress) (*DepositAddress, error)
+	GetDepositAddressByUserId(ctx context.Context, userId int64) (*DepositAddress, error)
+	GetDepositAddressByAddress(ctx context.Context, address string) (*DepositAddress, error)
+	UpdateDepositAddressProduct(ctx context.Context, userId int64, productId int64) error
+	// GetDepositAddresses 全部充值地址，key为小写地址
+	GetDepositAddresses(ctx context.Context) (map[string]*DepositAddress, error)
+}
+
+// AddressDeriver 按索引派生充值地址，只持有扩展公钥
+type AddressDeriver interface {
+	DeriveAddress(index int64) (string, error)
+}
+
+const (
+	depositMinAmount int64 = 100000000000 // 最少充值10
+	depositRate      int64 = 10           // 充值入账倍数
+
+	depositStatusPair = "pair" // 等待配对
+)
+
+// depositCursorName 每个代币单独的扫描游标
+func depositCursorName(symbol string) string {
+	return "deposit_" + strings.ToLower(symbol)
+}
+
+// depositTransferKey 一笔交易可以有多笔转入，按(hash, log_index)区分
+func depositTransferKey(hash string, logIndex int64) string {
+	return hash + "-" + strconv.FormatInt(logIndex, 10)
+}
+
+// DepositHandle 按代币从各自游标处扫描已确认的转入记录，按代币的充值规则入账，end之后不再继续
+func (ruc *RecordUseCase) DepositHandle(ctx context.Context, end time.Time) error {
+	var (
+		configs          []*Config
+		confirmations    int64 = 15
+		blockRange       int64 = 2000
+		startBlock       int64
+		latestBlock      int64
+		tokens           []*Token
+		depositAddresses map[string]*DepositAddress
+		err              error
+	)
+
+	// 配置
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "deposit_confirmations", "deposit_block_range", "deposit_start_block")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_confirmations" == vConfig.KeyName {
+				confirmations, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "deposit_block_range" == vConfig.KeyName {
+				blockRange, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "deposit_start_block" == vConfig.KeyName {
+				startBlock, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if 0 >= blockRange {
+		blockRange = 2000
+	}
+
+	// 启用且配置了充值规则的代币
+	tokens, err = ruc.tokenRepo.GetTokens(ctx)
+	if nil != err {
+		return err
+	}
+	depositTokens := make(map[string]*Token, 0)
+	for _, vToken := range tokens {
+		if 1 == vToken.Status && "" != vToken.DepositRule {
+			depositTokens[vToken.Symbol] = vToken
+		}
+	}
+
+	// 监听全部用户充值地址，以及仍有用户转入的原收款地址
+	depositAddresses, err = ruc.depositAddressRepo.GetDepositAddresses(ctx)
+	if nil != err {
+		return err
+	}
+	toAddresses := make([]string, 0, len(depositAddresses)+1)
+	for k := range depositAddresses {
+		toAddresses = append(toAddresses, k)
+	}
+	if _, ok := depositAddresses[ruc.collectionAddress]; !ok && "" != ruc.collectionAddress {
+		toAddresses = append(toAddresses, ruc.collectionAddress)
+	}
+
+	latestBlock, err = ruc.depositSource.GetLatestBlockNumber(ctx)
+	if nil != err {
+		return err
+	}
+	safeBlock := latestBlock - confirmations // 确认数不足的区块不处理
+
+	pair := false
+	for _, vToken := range tokens {
+		if _, ok := depositTokens[vToken.Symbol]; !ok {
+			continue
+		}
+		if TokenDepositPair == vToken.DepositRule || TokenDepositPairCoin == vToken.DepositRule {
+			pair = true
+		}
+
+		if err = ruc.depositTokenHandle(ctx, vToken, depositAddresses, toAddresses, startBlock, safeBlock, blockRange, end); nil != err {
+			return err
+		}
+	}
+
+	if pair {
+		return ruc.depositPairHandle(ctx, depositTokens)
+	}
+
+	return nil
+}
+
+// depositTokenHandle 单个代币按区块范围向前扫描
+func (ruc *RecordUseCase) depositTokenHandle(ctx context.Context, token *Token, depositAddresses map[string]*DepositAddress, toAddresses []string, startBlock int64, safeBlock int64, blockRange int64, end time.Time) error {
+	var (
+		cursor     *DepositCursor
+		transfers  []*DepositTransfer
+		globalLock *GlobalLock
+		err        error
+	)
+
+	cursorName := depositCursorName(token.Symbol)
+	cursor, err = ruc.depositCursorRepo.GetDepositCursor(ctx, cursorName)
+	if nil != err && !errors.IsNotFound(err) {
+		return err
+	}
+	if nil == cursor {
+		// 首次运行，未配置起始区块时从当前已确认区块开始
+		if 0 >= startBlock {
+			startBlock = safeBlock
+		}
+		cursor = &DepositCursor{Name: cursorName, BlockNumber: startBlock}
+	}
+
+	for fromBlock := cursor.BlockNumber; fromBlock <= safeBlock; {
+		// 获取系统锁
+		globalLock, err = ruc.locationRepo.GetLockGlobalLocation(ctx)
+		if nil != err || 1 != globalLock.Status {
+			break
+		}
+
+		if end.Before(time.Now().UTC()) {
+			break
+		}
+
+		toBlock := fromBlock + blockRange - 1
+		if toBlock > safeBlock {
+			toBlock = safeBlock
+		}
+
+		transfers = nil
+		if 0 < len(toAddresses) {
+			transfers, err = ruc.depositSource.GetDepositTransfers(ctx, token.Contract, toAddresses, fromBlock, toBlock)
+			if nil != err {
+				return err
+			}
+		}
+
+		if err = ruc.depositTransfersHandle(ctx, token, depositAddresses, transfers); nil != err {
+			return err
+		}
+
+		// 整个区块范围处理完，游标推进到下一个区块，中途失败时重扫本范围，已处理的转入按(hash, log_index)跳过
+		cursor.BlockNumber = toBlock + 1
+		if err = ruc.depositCursorRepo.UpdateDepositCursor(ctx, cursorName, cursor.BlockNumber); nil != err {
+			return err
+		}
+
+		fromBlock = toBlock + 1
+	}
+
+	return nil
+}
+
+// depositTransfersHandle 按转入的充值地址匹配用户并按代币规则入账，每笔转入记录处理结果，已处理的(hash, log_index)跳过，重复扫描同一范围不会重复入单
+func (ruc *RecordUseCase) depositTransfersHandle(ctx context.Context, token *Token, depositAddresses map[string]*DepositAddress, transfers []*DepositTransfer) error {
+	var (
+		notExistDepositResult []*EthUserRecord
+		existEthUserRecords   []*EthUserRecord
+		existInboundTransfers []*InboundTransfer
+		inboundTransfers      []*InboundTransfer
+		hashKeys              []string
+		err                   error
+	)
+
+	if 0 >= len(transfers) {
+		return nil
+	}
+
+	for _, vTransfer := range transfers {
+		hashKeys = append(hashKeys, vTransfer.Hash)
+	}
+
+	existEthUserRecords, err = ruc.ethUserRecordRepo.GetEthUserRecordsByHash(ctx, hashKeys...)
+	if nil != err {
+		return err
+	}
+	existRecordKeys := make(map[string]bool, 0)
+	legacyRecordHashes := make(map[string]bool, 0)
+	for _, v := range existEthUserRecords {
+		if "" == v.BlockHash { // 扫描之前按hash入账的记录，整笔交易都算已入账
+			legacyRecordHashes[v.Hash] = true
+			continue
+		}
+		existRecordKeys[depositTransferKey(v.Hash, v.LogIndex)] = true
+	}
+	existInboundTransfers, err = ruc.inboundTransferRepo.GetInboundTransfersByHash(ctx, hashKeys...)
+	if nil != err {
+		return err
+	}
+	existInboundKeys := make(map[string]bool, 0)
+	for _, v := range existInboundTransfers {
+		existInboundKeys[depositTransferKey(v.Hash, v.LogIndex)] = true
+	}
+
+	// 转入原收款地址的按转出地址认定用户
+	senders, err := ruc.collectionSenders(ctx, depositAddresses, transfers)
+	if nil != err {
+		return err
+	}
+
+	// 开仓位的一侧有最低金额和入账倍数
+	location := TokenDepositLocation == token.DepositRule || TokenDepositPair == token.DepositRule
+	status := "success"
+	if TokenDepositPair == token.DepositRule || TokenDepositPairCoin == token.DepositRule {
+		status = depositStatusPair
+	}
+
+	// 有可用套餐时按套餐的金额范围入单，否则用最低充值
+	products := make([]*Product, 0)
+	if location {
+		allProducts, err := ruc.productRepo.GetProducts(ctx)
+		if nil != err {
+			return err
+		}
+		products = activeProducts(allProducts, time.Now().UTC())
+	}
+
+	notExistDepositResult = make([]*EthUserRecord, 0)
+	recordInboundTransfers := make(map[string]*InboundTransfer, 0)
+	for _, vTransfer := range transfers {
+		transferKey := depositTransferKey(vTransfer.Hash, vTransfer.LogIndex)
+		if existRecordKeys[transferKey] || legacyRecordHashes[vTransfer.Hash] { // 记录已存在
+			continue
+		}
+		if existInboundKeys[transferKey] {
+			continue
+		}
+
+		inboundTransfer := &InboundTransfer{
+			Hash:        vTransfer.Hash,
+			LogIndex:    vTransfer.LogIndex,
+			FromAddress: vTransfer.From,
+			ToAddress:   vTransfer.To,
+			CoinType:    token.Symbol,
+			Value:       vTransfer.Value,
+			BlockNumber: vTransfer.BlockNumber,
+			BlockHash:   vTransfer.BlockHash,
+			Disposition: InboundError,
+		}
+		existRecordKeys[transferKey] = true // 同一范围重复返回的转入只处理一次
+		inboundTransfers = append(inboundTransfers, inboundTransfer)
+
+		depositAddress, ok := depositAddresses[vTransfer.To]
+		if !ok {
+			depositAddress, ok = senders[vTransfer.From]
+		}
+		if !ok { // 不是充值地址，转出地址也不是用户
+			inboundTransfer.Disposition = InboundUnknownSender
+			continue
+		}
+		inboundTransfer.UserId = depositAddress.UserId
+
+		// 按代币精度转为系统金额
+		value, ok := new(big.Int).SetString(vTransfer.Value, 10)
+		if !ok {
+			inboundTransfer.Remark = "金额格式错误"
+			continue
+		}
+		tmpValue, err := token.ToSystemAmount(value)
+		if nil != err {
+			inboundTransfer.Remark = err.Error()
+			continue
+		}
+		inboundTransfer.Amount = tmpValue
+		var productId int64
+		if location {
+			if 0 < len(products) {
+				product := matchProduct(products, tmpValue, depositAddress.ProductId)
+				if nil == product {
+					inboundTransfer.Disposition = InboundNoProduct
+					continue
+				}
+				productId = product.ID
+				inboundTransfer.ProductId = productId
+			} else if depositMinAmount > tmpValue {
+				inboundTransfer.Disposition = InboundBelowMinimum
+				continue
+			}
+			tmpValue *= depositRate
+		} else if 0 >= tmpValue {
+			inboundTransfer.Disposition = InboundBelowMinimum
+			continue
+		}
+		chainAmount, err := token.ToChainAmount(tmpValue)
+		if nil != err {
+			inboundTransfer.Remark = err.Error()
+			continue
+		}
+
+		recordInboundTransfers[transferKey] = inboundTransfer
+		notExistDepositResult = append(notExistDepositResult, &EthUserRecord{
+			UserId:      depositAddress.UserId,
+			Hash:        vTransfer.Hash,
+			LogIndex:    vTransfer.LogIndex,
+			Status:      status,
+			Type:        "deposit",
+			Amount:      chainAmount.String(),
+			RelAmount:   tmpValue,
+			CoinType:    token.Symbol,
+			BlockNumber: vTransfer.BlockNumber,
+			BlockHash:   vTransfer.BlockHash,
+			ProductId:   productId,
+		})
+	}
+
+	switch token.DepositRule {
+	case TokenDepositLocation:
+		_, err = ruc.EthUserRecordHandle(ctx, notExistDepositResult...)
+		if nil != err {
+			ruc.log.Errorf("deposit %s location: %v", token.Symbol, err)
+		}
+	case TokenDepositBalance:
+		ruc.depositBalanceHandle(ctx, notExistDepositResult...)
+	case TokenDepositPair, TokenDepositPairCoin:
+		// 先记为待配对，配对后再入账
+		for _, v := range notExistDepositResult {
+			if _, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, v); nil != err {
+				return err
+			}
+			v.Disposition = InboundPendingPair
+		}
+	}
+
+	for _, v := range notExistDepositResult {
+		recordInboundTransfers[depositTransferKey(v.Hash, v.LogIndex)].Disposition = v.Disposition
+	}
+	for _, v := range inboundTransfers {
+		if InboundCredited != v.Disposition && InboundPendingPair != v.Disposition {
+			ruc.log.Warnf("deposit not credited: hash %s to %s disposition %s", v.Hash, v.ToAddress, v.Disposition)
+		}
+		if _, err = ruc.inboundTransferRepo.CreateInboundTransfer(ctx, v); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectionSenders 转入原收款地址的转出用户，按用户钱包地址匹配，key为小写转出地址
+func (ruc *RecordUseCase) collectionSenders(ctx context.Context, depositAddresses map[string]*DepositAddress, transfers []*DepositTransfer) (map[string]*DepositAddress, error) {
+	res := make(map[string]*DepositAddress, 0)
+	if "" == ruc.collectionAddress {
+		return res, nil
+	}
+
+	// 用户地址历史上有小写和checksum两种写法
+	fromAddresses := make([]string, 0)
+	for _, vTransfer := range transfers {
+		if _, ok := depositAddresses[vTransfer.To]; ok || ruc.collectionAddress != vTransfer.To {
+			continue
+		}
+		fromAddresses = append(fromAddresses, vTransfer.From, common.HexToAddress(vTransfer.From).Hex())
+	}
+	if 0 >= len(fromAddresses) {
+		return res, nil
+	}
+
+	users, err := ruc.userRepo.GetUserByAddresses(ctx, fromAddresses...)
+	if nil != err && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	for _, vUser := range users {
+		res[strings.ToLower(vUser.Address)] = &DepositAddress{UserId: vUser.ID}
+	}
+
+	return res, nil
+}
+
+// depositBalanceHandle 充值直接入对应币种余额
+func (ruc *RecordUseCase) depositBalanceHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) {
+	for _, v := range ethUserRecord {
+		if err := ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			_, err := ruc.userBalanceRepo.DepositBalance(ctx, v.UserId, v.RelAmount, strings.ToLower(v.CoinType))
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, v)
+			return err
+		}); nil != err {
+			ruc.log.Errorf("deposit %s balance: %v", v.Hash, err)
+			v.Disposition = InboundError
+			continue
+		}
+
+		v.Disposition = InboundCredited
+	}
+}
+
+// depositPairHandle usdt按deposit_pair_rate(百分比)配足dhb后开仓位，dhb随仓位入余额，每个用户每次配对一笔
+func (ruc *RecordUseCase) depositPairHandle(ctx context.Context, tokens map[string]*Token) error {
+	var (
+		configs  []*Config
+		pairRate int64 = 100
+		records  []*EthUserRecord
+		err      error
+	)
+
+	// 配置
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "deposit_pair_rate")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_pair_rate" == vConfig.KeyName {
+				pairRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	records, err = ruc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, depositStatusPair)
+	if nil != err {
+		return err
+	}
+
+	locationRecords := make(map[int64][]*EthUserRecord, 0)
+	coinRecords := make(map[int64][]*EthUserRecord, 0)
+	pendingRecords := make(map[int64]*EthUserRecord, 0)
+	for _, v := range records {
+		pendingRecords[v.ID] = v
+		token, ok := tokens[v.CoinType]
+		if !ok {
+			continue
+		}
+		value, ok := new(big.Int).SetString(v.Amount, 10)
+		if !ok {
+			continue
+		}
+		v.RelAmount, err = token.ToSystemAmount(value)
+		if nil != err {
+			continue
+		}
+
+		// 开仓位和入币的一侧由代币的充值规则决定
+		switch token.DepositRule {
+		case TokenDepositPair:
+			locationRecords[v.UserId] = append(locationRecords[v.UserId], v)
+		case TokenDepositPairCoin:
+			coinRecords[v.UserId] = append(coinRecords[v.UserId], v)
+		}
+	}
+
+	pairRecords := make([]*EthUserRecord, 0)
+	for userId, vLocationRecords := range locationRecords {
+		record := vLocationRecords[0]
+		need := record.RelAmount * pairRate / 100
+		for _, vCoinRecord := range coinRecords[userId] {
+			if record.CoinAmount >= need {
+				break
+			}
+			record.CoinAmount += vCoinRecord.RelAmount
+			record.PairIds = append(record.PairIds, vCoinRecord.ID)
+		}
+		if record.CoinAmount < need { // dhb不足继续等待
+			continue
+		}
+
+		record.Status = "success"
+		pairRecords = append(pairRecords, record)
+	}
+
+	_, err = ruc.EthUserRecordHandle(ctx, pairRecords...)
+	if nil != err {
+		ruc.log.Errorf("deposit pair location: %v", err)
+	}
+
+	for _, v := range pairRecords {
+		// 没开成仓位的记录仍为待配对，下次扫描重试，转入记录改为未入账的原因
+		if InboundCredited != v.Disposition {
+			if err = ruc.inboundTransferRepo.UpdateInboundTransferDisposition(ctx, v.Hash, v.LogIndex, InboundPendingPair, v.Disposition); nil != err {
+				return err
+			}
+			continue
+		}
+
+		// 配对入账的转入改为已入账
+		if err = ruc.inboundTransferRepo.UpdateInboundTransferDisposition(ctx, v.Hash, v.LogIndex, InboundLocationRunning, InboundCredited); nil != err {
+			return err
+		}
+		if err = ruc.inboundTransferRepo.UpdateInboundTransferDisposition(ctx, v.Hash, v.LogIndex, InboundError, InboundCredited); nil != err {
+			return err
+		}
+		credited := []*EthUserRecord{v}
+		for _, vPairId := range v.PairIds {
+			credited = append(credited, pendingRecords[vPairId])
+		}
+		for _, vRecord := range credited {
+			if err = ruc.inboundTransferRepo.UpdateInboundTransferDisposition(ctx, vRecord.Hash, vRecord.LogIndex, InboundPendingPair, InboundCredited); nil != err {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// AdminDepositReorgCheck 最近区块内已入账充值的区块hash变化时，交易已不在链上的标记回滚待人工冲正
+func (ruc *RecordUseCase) AdminDepositReorgCheck(ctx context.Context, req *v1.AdminDepositReorgCheckRequest) (*v1.AdminDepositReorgCheckReply, error) {
+	var (
+		configs     []*Config
+		window      int64 = 1000
+		latestBlock int64
+		records     []*EthUserRecord
+		err         error
+	)
+
+	// 配置
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "deposit_reorg_window")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_reorg_window" == vConfig.KeyName {
+				window, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	latestBlock, err = ruc.withdrawChain.GetLatestBlockNumber(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	records, err = ruc.ethUserRecordRepo.GetEthUserRecordsFromBlock(ctx, latestBlock-window)
+	if nil != err {
+		return nil, err
+	}
+
+	blockHashes := make(map[int64]string, 0)
+	for _, record := range records {
+		blockHash, ok := blockHashes[record.BlockNumber]
+		if !ok {
+			blockHash, err = ruc.withdrawChain.GetBlockHash(ctx, record.BlockNumber)
+			if nil != err {
+				return nil, err
+			}
+			blockHashes[record.BlockNumber] = blockHash
+		}
+		if strings.EqualFold(blockHash, record.BlockHash) {
+			continue
+		}
+
+		// 交易可能被重新打包进其他区块
+		receipt, err := ruc.withdrawChain.GetTransactionReceipt(ctx, record.Hash)
+		if nil != err {
+			return nil, err
+		}
+		if nil != receipt && 1 == receipt.Status {
+			if err = ruc.ethUserRecordRepo.UpdateEthUserRecordBlock(ctx, record.ID, receipt.BlockNumber, receipt.BlockHash); nil != err {
+				return nil, err
+			}
+			continue
+		}
+
+		ruc.log.Errorf("deposit reorg: record %d hash %s block %d", record.ID, record.Hash, record.BlockNumber)
+		if err = ruc.ethUserRecordRepo.UpdateEthUserRecordReorg(ctx, record.ID, 0, 1); nil != err {
+			return nil, err
+		}
+	}
+
+	return &v1.AdminDepositReorgCheckReply{}, nil
+}
+
+func (ruc *RecordUseCase) AdminDepositReorgList(ctx context.Context, req *v1.AdminDepositReorgListRequest) (*v1.AdminDepositReorgListReply, error) {
+	var (
+		records []*EthUserRecord
+		userIds []int64
+		users   map[int64]*User
+		count   int64
+		err     error
+	)
+
+	res := &v1.AdminDepositReorgListReply{
+		Records: make([]*v1.AdminDepositReorgListReply_List, 0),
+	}
+
+	reorg := req.Reorg
+	if 0 >= reorg {
+		reorg = 1
+	}
+	records, err, count = ruc.ethUserRecordRepo.GetEthUserRecordsByReorg(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, reorg)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	for _, v := range records {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = ruc.userRepo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	for _, v := range records {
+		var address string
+		if user, ok := users[v.UserId]; ok {
+			address = user.Address
+		}
+
+		res.Records = append(res.Records, &v1.AdminDepositReorgListReply_List{
+			Id:          v.ID,
+			UserId:      v.UserId,
+			Address:     address,
+			Hash:        v.Hash,
+			Amount:      v.Amount,
+			CoinType:    v.CoinType,
+			BlockNumber: v.BlockNumber,
+			BlockHash:   v.BlockHash,
+			Reorg:       v.Reorg,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (ruc *RecordUseCase) AdminDepositReorgResolve(ctx context.Context, req *v1.AdminDepositReorgResolveRequest) (*v1.AdminDepositReorgResolveReply, error) {
+	if err := ruc.ethUserRecordRepo.UpdateEthUserRecordReorg(ctx, req.SendBody.Id, 1, 2); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminDepositReorgResolveReply{}, nil
+}
+
+// AdminDepositLookup 按交易hash查充值处理情况，hash统一小写，一笔交易的每个转账log都返回
+func (ruc *RecordUseCase) AdminDepositLookup(ctx context.Context, req *v1.AdminDepositLookupRequest) (*v1.AdminDepositLookupReply, error) {
+	var (
+		records          []*EthUserRecord
+		inboundTransfers []*InboundTransfer
+		locations        map[int64]*LocationNew
+		locationIds      []int64
+		rewards          []*Reward
+		userIds          []int64
+		users            map[int64]*User
+		err              error
+	)
+
+	res := &v1.AdminDepositLookupReply{
+		Records:   make([]*v1.AdminDepositLookupReply_Record, 0),
+		Rewards:   make([]*v1.AdminDepositLookupReply_Reward, 0),
+		Transfers: make([]*v1.AdminDepositLookupReply_Transfer, 0),
+	}
+
+	hash := strings.ToLower(strings.TrimSpace(req.TxHash))
+	if "" == hash {
+		return res, errors.New(500, "DEPOSIT_LOOKUP_ERROR", "请填写交易hash")
+	}
+
+	inboundTransfers, err = ruc.inboundTransferRepo.GetInboundTransfersByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	for _, v := range inboundTransfers {
+		res.Transfers = append(res.Transfers, &v1.AdminDepositLookupReply_Transfer{
+			Id:          v.ID,
+			LogIndex:    v.LogIndex,
+			ToAddress:   v.ToAddress,
+			Value:       v.Value,
+			Disposition: v.Disposition,
+			Resolution:  v.Resolution,
+		})
+	}
+
+	records, err = ruc.ethUserRecordRepo.GetEthUserRecordsByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	if 0 == len(records) {
+		if 0 == len(res.Transfers) {
+			return res, errors.NotFound("DEPOSIT_NOT_FOUND", "未找到该交易的充值")
+		}
+		return res, nil
+	}
+
+	// 仓位，同一仓位的收益只查一次
+	locations = make(map[int64]*LocationNew, 0)
+	for _, record := range records {
+		userIds = append(userIds, record.UserId)
+		if 0 >= record.LocationId {
+			continue
+		}
+		if _, ok := locations[record.LocationId]; ok {
+			continue
+		}
+
+		location, err := ruc.locationRepo.GetLocationNewById(ctx, record.LocationId)
+		if nil != err {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return res, err
+		}
+		locations[record.LocationId] = location
+		locationIds = append(locationIds, record.LocationId)
+	}
+
+	for _, locationId := range locationIds {
+		var locationRewards []*Reward
+		locationRewards, err = ruc.userBalanceRepo.GetRewardsByLocationNewId(ctx, locationId)
+		if nil != err {
+			return res, err
+		}
+		for _, v := range locationRewards {
+			userIds = append(userIds, v.UserId)
+			res.Rewards = append(res.Rewards, &v1.AdminDepositLookupReply_Reward{
+				Id:         v.ID,
+				UserId:     v.UserId,
+				Amount:     fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+				Type:       v.Type,
+				Reason:     v.Reason,
+				CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+				LocationId: locationId,
+			})
+		}
+		rewards = append(rewards, locationRewards...)
+	}
+
+	users, err = ruc.userRepo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		users = make(map[int64]*User, 0)
+	}
+	for i, v := range rewards {
+		if user, ok := users[v.UserId]; ok {
+			res.Rewards[i].Address = user.Address
+		}
+	}
+
+	for _, record := range records {
+		var address string
+		if user, ok := users[record.UserId]; ok {
+			address = user.Address
+		}
+
+		resRecord := &v1.AdminDepositLookupReply_Record{
+			Id:          record.ID,
+			UserId:      record.UserId,
+			Address:     address,
+			Hash:        record.Hash,
+			Status:      record.Status,
+			Amount:      record.Amount,
+			CoinType:    record.CoinType,
+			BlockNumber: record.BlockNumber,
+			Reorg:       record.Reorg,
+			CreatedAt:   record.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			LogIndex:    record.LogIndex,
+			Type:        record.Type,
+		}
+
+		if location, ok := locations[record.LocationId]; ok {
+			resRecord.Location = &v1.AdminDepositLookupReply_Location{
+				Id:         location.ID,
+				Status:     location.Status,
+				Current:    fmt.Sprintf("%.2f", float64(location.Current)/float64(10000000000)),
+				CurrentMax: fmt.Sprintf("%.2f", float64(location.CurrentMax)/float64(10000000000)),
+				CreatedAt:  location.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			}
+		}
+
+		if 0 < record.BalanceRecordId {
+			balanceRecord, err := ruc.userBalanceRepo.GetUserBalanceRecordById(ctx, record.BalanceRecordId)
+			if nil != err && !errors.IsNotFound(err) {
+				return res, err
+			}
+			if nil != balanceRecord {
+				resRecord.BalanceRecord = &v1.AdminDepositLookupReply_BalanceRecord{
+					Id:        balanceRecord.ID,
+					Amount:    fmt.Sprintf("%.2f", float64(balanceRecord.Amount)/float64(10000000000)),
+					Balance:   fmt.Sprintf("%.2f", float64(balanceRecord.Balance)/float64(10000000000)),
+					Type:      balanceRecord.Type,
+					CoinType:  balanceRecord.CoinType,
+					CreatedAt: balanceRecord.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+				}
+			}
+		}
+
+		res.Records = append(res.Records, resRecord)
+	}
+
+	return res, nil
+}
+
+// AdminDepositCredit 扫描漏掉的充值按(hash, log_index)从链上取转账补入账，转入原收款地址的按转出地址认定用户，金额需和链上一致，校验和入账都走扫描的流程，已有入账或转入记录的不处理
+func (ruc *RecordUseCase) AdminDepositCredit(ctx context.Context, req *v1.AdminDepositCreditRequest) (*v1.AdminDepositCreditReply, error) {
+	var (
+		configs          []*Config
+		confirmations    int64 = 15
+		latestBlock      int64
+		transfers        []*DepositTransfer
+		tokens           []*Token
+		depositAddresses map[string]*DepositAddress
+		records          []*EthUserRecord
+		inboundTransfers []*InboundTransfer
+		globalLock       *GlobalLock
+		err              error
+	)
+
+	res := &v1.AdminDepositCreditReply{}
+
+	hash := strings.ToLower(strings.TrimSpace(req.SendBody.TxHash))
+	if "" == hash {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "请填写交易hash")
+	}
+	if 0 > req.SendBody.LogIndex {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "log序号错误")
+	}
+	creditAmount, err := parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return res, err
+	}
+	if 0 >= creditAmount {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "请填写转账金额")
+	}
+
+	// 扫描之前按hash入账的记录整笔交易都算已入账
+	records, err = ruc.ethUserRecordRepo.GetEthUserRecordsByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	for _, v := range records {
+		if "" == v.BlockHash || req.SendBody.LogIndex == v.LogIndex {
+			return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "该转账已有入账记录")
+		}
+	}
+	inboundTransfers, err = ruc.inboundTransferRepo.GetInboundTransfersByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	for _, v := range inboundTransfers {
+		if req.SendBody.LogIndex == v.LogIndex {
+			return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "该转账已扫描，请在转入记录中处理")
+		}
+	}
+
+	// 和扫描一样需要系统锁
+	globalLock, err = ruc.locationRepo.GetLockGlobalLocation(ctx)
+	if nil != err || 1 != globalLock.Status {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "系统结算中，请稍后再试")
+	}
+
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "deposit_confirmations")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_confirmations" == vConfig.KeyName {
+				confirmations, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	transfers, err = ruc.depositSource.GetDepositTransfersByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	if 0 >= len(transfers) {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "交易不存在、未成功或没有代币转账")
+	}
+
+	tokens, err = ruc.tokenRepo.GetTokens(ctx)
+	if nil != err {
+		return res, err
+	}
+	depositTokens := make(map[string]*Token, 0)
+	contractTokens := make(map[string]*Token, 0)
+	for _, vToken := range tokens {
+		if 1 == vToken.Status && "" != vToken.DepositRule {
+			depositTokens[vToken.Symbol] = vToken
+			contractTokens[strings.ToLower(vToken.Contract)] = vToken
+		}
+	}
+
+	depositAddresses, err = ruc.depositAddressRepo.GetDepositAddresses(ctx)
+	if nil != err {
+		return res, err
+	}
+
+	// 按log序号取转账，同一交易的多笔转账分别补入账
+	var transfer *DepositTransfer
+	for _, vTransfer := range transfers {
+		if req.SendBody.LogIndex == vTransfer.LogIndex {
+			transfer = vTransfer
+			break
+		}
+	}
+	if nil == transfer {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "交易中没有该log序号的代币转账")
+	}
+	token, ok := contractTokens[transfer.Contract]
+	if !ok {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "该转账不是可充值代币")
+	}
+	if _, ok = depositAddresses[transfer.To]; !ok {
+		if "" == ruc.collectionAddress || ruc.collectionAddress != transfer.To {
+			return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "该转账不是转入充值地址或原收款地址")
+		}
+
+		// 转入原收款地址的和扫描一样按转出地址认定用户
+		senders, err := ruc.collectionSenders(ctx, depositAddresses, []*DepositTransfer{transfer})
+		if nil != err {
+			return res, err
+		}
+		if _, ok = senders[transfer.From]; !ok {
+			return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "转入原收款地址的转出地址不是用户")
+		}
+	}
+
+	latestBlock, err = ruc.depositSource.GetLatestBlockNumber(ctx)
+	if nil != err {
+		return res, err
+	}
+	if transfer.BlockNumber > latestBlock-confirmations {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "交易确认数不足")
+	}
+
+	value, ok := new(big.Int).SetString(transfer.Value, 10)
+	if !ok {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "转账金额格式错误")
+	}
+	transferAmount, err := token.ToSystemAmount(value)
+	if nil != err {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", err.Error())
+	}
+	if creditAmount != transferAmount {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "转账金额不一致")
+	}
+
+	if err = ruc.depositTransfersHandle(ctx, token, depositAddresses, []*DepositTransfer{transfer}); nil != err {
+		return res, err
+	}
+	if TokenDepositPair == token.DepositRule || TokenDepositPairCoin == token.DepositRule {
+		if err = ruc.depositPairHandle(ctx, depositTokens); nil != err {
+			return res, err
+		}
+	}
+
+	// 处理结果以转入记录为准
+	inboundTransfers, err = ruc.inboundTransferRepo.GetInboundTransfersByHash(ctx, hash)
+	if nil != err {
+		return res, err
+	}
+	var inboundTransfer *InboundTransfer
+	for _, v := range inboundTransfers {
+		if transfer.LogIndex == v.LogIndex {
+			inboundTransfer = v
+		}
+	}
+	if nil == inboundTransfer {
+		return res, errors.New(500, "DEPOSIT_CREDIT_ERROR", "入账失败")
+	}
+	res.UserId = inboundTransfer.UserId
+	res.CoinType = inboundTransfer.CoinType
+	res.Disposition = inboundTransfer.Disposition
+
+	return res, nil
+}
@@ -0,0 +1,505 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+)
+
+type testUserRepo struct {
+	UserRepo
+	users []*User
+}
+
+func (r *testUserRepo) GetUserByAddresses(ctx context.Context, addresses ...string) (map[string]*User, error) {
+	res := make(map[string]*User, 0)
+	for _, a := range addresses {
+		for _, u := range r.users {
+			if a == u.Address {
+				res[u.Address] = u
+			}
+		}
+	}
+	return res, nil
+}
+
+func TestCollectionSenders(t *testing.T) {
+	const (
+		collection = "0x8aaccab66c923ae3a3ff96d23c6ac73aa365a858"
+		deposit    = "0x1111111111111111111111111111111111111111"
+		checksum   = "0x52908400098527886E0F7030069857D2E4169EE7" // 老用户地址按checksum保存
+		lower      = "0xde709f2102306220921060314715629080e2fb77"
+		stranger   = "0x3333333333333333333333333333333333333333"
+	)
+	ruc := &RecordUseCase{
+		userRepo:          &testUserRepo{users: []*User{{ID: 1, Address: checksum}, {ID: 2, Address: lower}, {ID: 3, Address: "0x27b1fdb04752bbc536007a920d24acb045561c26"}}},
+		collectionAddress: collection,
+	}
+
+	senders, err := ruc.collectionSenders(context.Background(), map[string]*DepositAddress{deposit: {UserId: 9}}, []*DepositTransfer{
+		{From: "0x52908400098527886e0f7030069857d2e4169ee7", To: collection},
+		{From: lower, To: collection},
+		{From: stranger, To: collection},
+		{From: "0x27b1fdb04752bbc536007a920d24acb045561c26", To: deposit}, // 转入充值地址的按充值地址认定
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if 2 != len(senders) {
+		t.Fatalf("senders %d, want 2", len(senders))
+	}
+	if v, ok := senders["0x52908400098527886e0f7030069857d2e4169ee7"]; !ok || 1 != v.UserId {
+		t.Fatalf("checksum sender %v", v)
+	}
+	if v, ok := senders[lower]; !ok || 2 != v.UserId {
+		t.Fatalf("lower sender %v", v)
+	}
+	if _, ok := senders[stranger]; ok {
+		t.Fatal("stranger should be unknown_sender")
+	}
+}
+
+type testEthUserRecordRepo struct {
+	EthUserRecordRepo
+	records []*EthUserRecord
+}
+
+func (r *testEthUserRecordRepo) GetEthUserRecordsByHash(ctx context.Context, hash ...string) ([]*EthUserRecord, error) {
+	res := make([]*EthUserRecord, 0)
+	for _, h := range hash {
+		for _, v := range r.records {
+			if h == v.Hash {
+				res = append(res, v)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *testEthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, record *EthUserRecord) (*EthUserRecord, error) {
+	record.ID = int64(len(r.records) + 1)
+	r.records = append(r.records, record)
+	return record, nil
+}
+
+type testInboundTransferRepo struct {
+	InboundTransferRepo
+	transfers []*InboundTransfer
+}
+
+func (r *testInboundTransferRepo) CreateInboundTransfer(ctx context.Context, t *InboundTransfer) (*InboundTransfer, error) {
+	t.ID = int64(len(r.transfers) + 1)
+	r.transfers = append(r.transfers, t)
+	return t, nil
+}
+
+func (r *testInboundTransferRepo) GetInboundTransfersByHash(ctx context.Context, hash ...string) ([]*InboundTransfer, error) {
+	res := make([]*InboundTransfer, 0)
+	for _, h := range hash {
+		for _, v := range r.transfers {
+			if h == v.Hash {
+				res = append(res, v)
+			}
+		}
+	}
+	return res, nil
+}
+
+type testDepositBalanceRepo struct {
+	UserBalanceRepo
+	amounts map[int64]int64
+}
+
+func (r *testDepositBalanceRepo) DepositBalance(ctx context.Context, userId int64, amount int64, coinType string) (int64, error) {
+	r.amounts[userId] += amount
+	return 1, nil
+}
+
+func TestDepositTransfersPerLog(t *testing.T) {
+	const (
+		hash    = "0x6a1f5c8a0c3e4d1e1d2b1b3f8e5b6c2e6e9e0a4c7d1f2e3a4b5c6d7e8f9a0b1c"
+		deposit = "0x1111111111111111111111111111111111111111"
+	)
+	records := &testEthUserRecordRepo{}
+	inbound := &testInboundTransferRepo{}
+	balances := &testDepositBalanceRepo{amounts: make(map[int64]int64, 0)}
+	ruc := &RecordUseCase{
+		ethUserRecordRepo:   records,
+		inboundTransferRepo: inbound,
+		userBalanceRepo:     balances,
+		userRepo:            &testUserRepo{},
+		tx:                  &testTx{},
+		log:                 log.NewHelper(log.DefaultLogger),
+	}
+	token := &Token{Symbol: "DHB", Decimals: 18, Status: 1, DepositRule: TokenDepositBalance}
+	depositAddresses := map[string]*DepositAddress{deposit: {UserId: 9}}
+	transfer := func(logIndex int64) *DepositTransfer {
+		return &DepositTransfer{Hash: hash, LogIndex: logIndex, To: deposit, Value: "1000000000000000000", BlockNumber: 100, BlockHash: "0xb1"}
+	}
+
+	if err := ruc.depositTransfersHandle(context.Background(), token, depositAddresses, []*DepositTransfer{transfer(0)}); nil != err {
+		t.Fatal(err)
+	}
+
+	// 同一交易的另一条log，之前的转入记录不影响
+	if err := ruc.depositTransfersHandle(context.Background(), token, depositAddresses, []*DepositTransfer{transfer(0), transfer(3)}); nil != err {
+		t.Fatal(err)
+	}
+
+	if 2 != len(inbound.transfers) {
+		t.Fatalf("inbound transfers %d, want 2", len(inbound.transfers))
+	}
+	for _, v := range inbound.transfers {
+		if InboundCredited != v.Disposition || "0xb1" != v.BlockHash {
+			t.Fatalf("log %d disposition %s block hash %s", v.LogIndex, v.Disposition, v.BlockHash)
+		}
+	}
+	if 2 != len(records.records) || 3 != records.records[1].LogIndex {
+		t.Fatalf("records %v", records.records)
+	}
+	if 20000000000 != balances.amounts[9] {
+		t.Fatalf("balance %d, want 20000000000", balances.amounts[9])
+	}
+}
+
+type testDepositSource struct {
+	DepositSource
+	latestBlock int64
+	transfers   []*DepositTransfer
+}
+
+func (s *testDepositSource) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	return s.latestBlock, nil
+}
+
+func (s *testDepositSource) GetDepositTransfersByHash(ctx context.Context, hash string) ([]*DepositTransfer, error) {
+	return s.transfers, nil
+}
+
+type testDepositTokenRepo struct {
+	TokenRepo
+	tokens []*Token
+}
+
+func (r *testDepositTokenRepo) GetTokens(ctx context.Context) ([]*Token, error) {
+	return r.tokens, nil
+}
+
+type testDepositAddressRepo struct {
+	DepositAddressRepo
+	addresses map[string]*DepositAddress
+}
+
+func (r *testDepositAddressRepo) GetDepositAddresses(ctx context.Context) (map[string]*DepositAddress, error) {
+	return r.addresses, nil
+}
+
+func TestAdminDepositCreditPerLog(t *testing.T) {
+	const (
+		hash     = "0x6a1f5c8a0c3e4d1e1d2b1b3f8e5b6c2e6e9e0a4c7d1f2e3a4b5c6d7e8f9a0b1c"
+		contract = "0x42f6b5d0ee7c7a3e5d2b1cbb06f3f43b0b5a0b0d"
+		deposit  = "0x1111111111111111111111111111111111111111"
+	)
+	source := &testDepositSource{latestBlock: 120, transfers: []*DepositTransfer{
+		{Hash: hash, LogIndex: 1, Contract: contract, To: deposit, Value: "1000000000000000000", BlockNumber: 100, BlockHash: "0xb1"},
+		{Hash: hash, LogIndex: 4, Contract: contract, To: deposit, Value: "2500000000000000000", BlockNumber: 110, BlockHash: "0xb1"},
+	}}
+	records := &testEthUserRecordRepo{}
+	inbound := &testInboundTransferRepo{}
+	balances := &testDepositBalanceRepo{amounts: make(map[int64]int64, 0)}
+	ruc := &RecordUseCase{
+		ethUserRecordRepo:   records,
+		inboundTransferRepo: inbound,
+		userBalanceRepo:     balances,
+		userRepo:            &testUserRepo{},
+		configRepo:          &testConfigRepo{values: map[string]string{"deposit_confirmations": "15"}},
+		locationRepo:        &testLocationRepo{},
+		depositSource:       source,
+		tokenRepo:           &testDepositTokenRepo{tokens: []*Token{{Symbol: "DHB", Contract: contract, Decimals: 18, Status: 1, DepositRule: TokenDepositBalance}}},
+		depositAddressRepo:  &testDepositAddressRepo{addresses: map[string]*DepositAddress{deposit: {UserId: 9}}},
+		tx:                  &testTx{},
+		log:                 log.NewHelper(log.DefaultLogger),
+	}
+	credit := func(logIndex int64, amount string) (*v1.AdminDepositCreditReply, error) {
+		return ruc.AdminDepositCredit(context.Background(), &v1.AdminDepositCreditRequest{SendBody: &v1.AdminDepositCreditRequest_SendBody{TxHash: hash, LogIndex: logIndex, Amount: amount}})
+	}
+	assertError := func(err error, reason string) {
+		t.Helper()
+		if nil == err || reason != errors.FromError(err).Message {
+			t.Fatalf("want %s, got %v", reason, err)
+		}
+	}
+
+	_, err := credit(2, "1")
+	assertError(err, "交易中没有该log序号的代币转账")
+	_, err = credit(1, "1.5")
+	assertError(err, "转账金额不一致")
+	// 确认数按所选的转账判断
+	_, err = credit(4, "2.5")
+	assertError(err, "交易确认数不足")
+
+	res, err := credit(1, "1")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 9 != res.UserId || InboundCredited != res.Disposition {
+		t.Fatalf("credit %v", res)
+	}
+	_, err = credit(1, "1")
+	assertError(err, "该转账已有入账记录")
+
+	// 同一交易的另一笔转账可以单独补入账
+	source.latestBlock = 130
+	if _, err = credit(4, "2.5"); nil != err {
+		t.Fatal(err)
+	}
+	if 35000000000 != balances.amounts[9] {
+		t.Fatalf("balance %d, want 35000000000", balances.amounts[9])
+	}
+	if 2 != len(inbound.transfers) || 2 != len(records.records) {
+		t.Fatalf("inbound transfers %d records %d, want 2", len(inbound.transfers), len(records.records))
+	}
+}
+
+func TestAdminDepositCreditCollection(t *testing.T) {
+	const (
+		hash       = "0x7b2e6d9b1d4f5e2f2e3c2c4f9f6c7d3f7f0f1b5d8e2f3f4b5c6d7e8f9a0b1c2d"
+		contract   = "0x42f6b5d0ee7c7a3e5d2b1cbb06f3f43b0b5a0b0d"
+		collection = "0x8aaccab66c923ae3a3ff96d23c6ac73aa365a858"
+		checksum   = "0x52908400098527886E0F7030069857D2E4169EE7" // 老用户地址按checksum保存
+		stranger   = "0x3333333333333333333333333333333333333333"
+	)
+	balances := &testDepositBalanceRepo{amounts: make(map[int64]int64, 0)}
+	ruc := &RecordUseCase{
+		ethUserRecordRepo:   &testEthUserRecordRepo{},
+		inboundTransferRepo: &testInboundTransferRepo{},
+		userBalanceRepo:     balances,
+		userRepo:            &testUserRepo{users: []*User{{ID: 5, Address: checksum}}},
+		configRepo:          &testConfigRepo{values: map[string]string{"deposit_confirmations": "15"}},
+		locationRepo:        &testLocationRepo{},
+		depositSource: &testDepositSource{latestBlock: 120, transfers: []*DepositTransfer{
+			{Hash: hash, LogIndex: 0, Contract: contract, From: stranger, To: collection, Value: "1000000000000000000", BlockNumber: 100, BlockHash: "0xb2"},
+			{Hash: hash, LogIndex: 1, Contract: contract, From: "0x52908400098527886e0f7030069857d2e4169ee7", To: collection, Value: "3000000000000000000", BlockNumber: 100, BlockHash: "0xb2"},
+		}},
+		tokenRepo:          &testDepositTokenRepo{tokens: []*Token{{Symbol: "DHB", Contract: contract, Decimals: 18, Status: 1, DepositRule: TokenDepositBalance}}},
+		depositAddressRepo: &testDepositAddressRepo{addresses: map[string]*DepositAddress{}},
+		collectionAddress:  collection,
+		tx:                 &testTx{},
+		log:                log.NewHelper(log.DefaultLogger),
+	}
+	credit := func(logIndex int64, amount string) (*v1.AdminDepositCreditReply, error) {
+		return ruc.AdminDepositCredit(context.Background(), &v1.AdminDepositCreditRequest{SendBody: &v1.AdminDepositCreditRequest_SendBody{TxHash: hash, LogIndex: logIndex, Amount: amount}})
+	}
+
+	// 转出地址不是用户
+	if _, err := credit(0, "1"); nil == err || "转入原收款地址的转出地址不是用户" != errors.FromError(err).Message {
+		t.Fatalf("stranger: %v", err)
+	}
+
+	res, err := credit(1, "3")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 5 != res.UserId || InboundCredited != res.Disposition {
+		t.Fatalf("credit %v", res)
+	}
+	if 30000000000 != balances.amounts[5] {
+		t.Fatalf("balance %d, want 30000000000", balances.amounts[5])
+	}
+}
+
+func (r *testEthUserRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, status string) ([]*EthUserRecord, error) {
+	res := make([]*EthUserRecord, 0)
+	for _, v := range r.records {
+		if status == v.Status {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *testEthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, from string, to string) error {
+	for _, v := range r.records {
+		if id == v.ID && from == v.Status {
+			v.Status = to
+		}
+	}
+	return nil
+}
+
+func (r *testEthUserRecordRepo) UpdateEthUserRecordLocation(ctx context.Context, id int64, recordType string, locationId int64, balanceRecordId int64) error {
+	return nil
+}
+
+func (r *testInboundTransferRepo) UpdateInboundTransferDisposition(ctx context.Context, hash string, logIndex int64, from string, to string) error {
+	for _, v := range r.transfers {
+		if hash == v.Hash && logIndex == v.LogIndex && from == v.Disposition {
+			v.Disposition = to
+		}
+	}
+	return nil
+}
+
+func TestDepositPairByRule(t *testing.T) {
+	const deposit = "0x1111111111111111111111111111111111111111"
+	locations := &testLocationRepo{
+		locations: map[int64][]*LocationNew{9: {{ID: 1, UserId: 9, Status: "running", OutRate: 200}}},
+		topUps:    make(map[int64]int64, 0),
+		currents:  make(map[int64]int64, 0),
+	}
+	records := &testEthUserRecordRepo{}
+	inbound := &testInboundTransferRepo{}
+	ruc := &RecordUseCase{
+		ethUserRecordRepo:   records,
+		inboundTransferRepo: inbound,
+		configRepo:          &testConfigRepo{values: map[string]string{"deposit_pair_rate": "50", "out_rate": "200", "coin_price": "1000"}},
+		locationRepo:        locations,
+		productRepo:         &testProductRepo{},
+		userRecommendRepo:   &testUserRecommendRepo{recommends: map[int64]*UserRecommend{9: {UserId: 9}}},
+		userInfoRepo:        &testUserInfoRepo{},
+		userBalanceRepo:     &testLocationBalanceRepo{rewards: make(map[int64]int64, 0), topUps: make(map[int64]int64, 0)},
+		userRepo:            &testUserRepo{},
+		tx:                  &testTx{},
+		log:                 log.NewHelper(log.DefaultLogger),
+	}
+	// 开仓位的一侧不限于usdt
+	usdc := &Token{Symbol: "USDC", Decimals: 18, Status: 1, DepositRule: TokenDepositPair}
+	dhb := &Token{Symbol: "DHB", Decimals: 18, Status: 1, DepositRule: TokenDepositPairCoin}
+	tokens := map[string]*Token{"USDC": usdc, "DHB": dhb}
+	depositAddresses := map[string]*DepositAddress{deposit: {UserId: 9}}
+
+	ctx := context.Background()
+	if err := ruc.depositTransfersHandle(ctx, usdc, depositAddresses, []*DepositTransfer{{Hash: "0xa1", To: deposit, Value: "100000000000000000000", BlockNumber: 1, BlockHash: "0xb1"}}); nil != err {
+		t.Fatal(err)
+	}
+	// usdc按入账倍数计1000，deposit_pair_rate为50时需要500 dhb
+	if err := ruc.depositTransfersHandle(ctx, dhb, depositAddresses, []*DepositTransfer{{Hash: "0xa2", To: deposit, Value: "500000000000000000000", BlockNumber: 1, BlockHash: "0xb1"}}); nil != err {
+		t.Fatal(err)
+	}
+
+	// 有运行中的仓位时转入记录显示原因，仍待配对
+	if err := ruc.depositPairHandle(ctx, tokens); nil != err {
+		t.Fatal(err)
+	}
+	if InboundLocationRunning != inbound.transfers[0].Disposition || InboundPendingPair != inbound.transfers[1].Disposition {
+		t.Fatalf("dispositions %s %s", inbound.transfers[0].Disposition, inbound.transfers[1].Disposition)
+	}
+	if depositStatusPair != records.records[0].Status {
+		t.Fatalf("record status %s, want pair", records.records[0].Status)
+	}
+
+	// 仓位结束后下次扫描重试入账
+	locations.locations[9][0].Status = "stop"
+	if err := ruc.depositPairHandle(ctx, tokens); nil != err {
+		t.Fatal(err)
+	}
+	for _, v := range inbound.transfers {
+		if InboundCredited != v.Disposition {
+			t.Fatalf("%s disposition %s, want credited", v.Hash, v.Disposition)
+		}
+	}
+	for _, v := range records.records {
+		if "success" != v.Status {
+			t.Fatalf("%s status %s, want success", v.Hash, v.Status)
+		}
+	}
+}
+
+type testProductRepo struct {
+	ProductRepo
+}
+
+func (r *testProductRepo) GetProducts(ctx context.Context) ([]*Product, error) {
+	return []*Product{}, nil
+}
+
+func (r *testUserRepo) GetUserByUserIds(ctx context.Context, userIds ...int64) (map[int64]*User, error) {
+	res := make(map[int64]*User, 0)
+	for _, u := range r.users {
+		res[u.ID] = u
+	}
+	return res, nil
+}
+
+func (r *testLocationRepo) GetLocationNewById(ctx context.Context, id int64) (*LocationNew, error) {
+	for _, v := range r.locations {
+		for _, l := range v {
+			if id == l.ID {
+				return l, nil
+			}
+		}
+	}
+	return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+}
+
+type testLookupBalanceRepo struct {
+	UserBalanceRepo
+	rewards     map[int64][]*Reward
+	rewardCalls int
+}
+
+func (r *testLookupBalanceRepo) GetUserBalanceRecordById(ctx context.Context, id int64) (*UserBalanceRecord, error) {
+	return &UserBalanceRecord{ID: id, Type: "top_up", Amount: 1000000000000}, nil
+}
+
+func (r *testLookupBalanceRepo) GetRewardsByLocationNewId(ctx context.Context, locationId int64) ([]*Reward, error) {
+	r.rewardCalls++
+	return r.rewards[locationId], nil
+}
+
+func TestAdminDepositLookupPerLog(t *testing.T) {
+	const hash = "0x8c3f7e0c2e5a6f3a3f4d3d5a0a7d8e4a8a1a2c6e9f3a4a5c6d7e8f9a0b1c2d3e"
+	balances := &testLookupBalanceRepo{rewards: map[int64][]*Reward{
+		100: {
+			{ID: 1, UserId: 1, Amount: 50000000000, Type: "location", Reason: "recommend"},
+			{ID: 2, UserId: 1, Amount: 30000000000, Type: "system_reward_daily", Reason: "recommend_team"},
+		},
+	}}
+	ruc := &RecordUseCase{
+		ethUserRecordRepo: &testEthUserRecordRepo{records: []*EthUserRecord{
+			{ID: 1, UserId: 2, Hash: hash, LogIndex: 3, Status: "success", Type: "deposit", LocationId: 100, BalanceRecordId: 7},
+			{ID: 2, UserId: 2, Hash: hash, LogIndex: 5, Status: "success", Type: "top_up", LocationId: 100, BalanceRecordId: 8},
+		}},
+		inboundTransferRepo: &testInboundTransferRepo{transfers: []*InboundTransfer{
+			{ID: 1, Hash: hash, LogIndex: 3, Disposition: InboundCredited},
+			{ID: 2, Hash: hash, LogIndex: 5, Disposition: InboundCredited},
+		}},
+		locationRepo: &testLocationRepo{locations: map[int64][]*LocationNew{
+			2: {{ID: 100, UserId: 2, Status: "running"}},
+		}},
+		userBalanceRepo: balances,
+		userRepo:        &testUserRepo{users: []*User{{ID: 1, Address: "0xa1"}, {ID: 2, Address: "0xa2"}}},
+		log:             log.NewHelper(log.DefaultLogger),
+	}
+
+	res, err := ruc.AdminDepositLookup(context.Background(), &v1.AdminDepositLookupRequest{TxHash: hash})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != len(res.Records) || 2 != len(res.Transfers) {
+		t.Fatalf("records %d transfers %d, want 2", len(res.Records), len(res.Transfers))
+	}
+	for i, logIndex := range []int64{3, 5} {
+		if logIndex != res.Records[i].LogIndex || logIndex != res.Transfers[i].LogIndex {
+			t.Fatalf("log %d: record %d transfer %d", logIndex, res.Records[i].LogIndex, res.Transfers[i].LogIndex)
+		}
+		if nil == res.Records[i].Location || 100 != res.Records[i].Location.Id || nil == res.Records[i].BalanceRecord {
+			t.Fatalf("log %d: %v", logIndex, res.Records[i])
+		}
+	}
+	if "top_up" != res.Records[1].Type || "0xa2" != res.Records[1].Address {
+		t.Fatalf("record %v", res.Records[1])
+	}
+	// 同一仓位的收益只查一次
+	if 1 != balances.rewardCalls || 2 != len(res.Rewards) || "0xa1" != res.Rewards[1].Address || 100 != res.Rewards[1].LocationId {
+		t.Fatalf("reward calls %d rewards %v", balances.rewardCalls, res.Rewards)
+	}
+
+	if _, err = ruc.AdminDepositLookup(context.Background(), &v1.AdminDepositLookupRequest{TxHash: "0x01"}); !errors.IsNotFound(err) {
+		t.Fatalf("want not found, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+// InboundTransfer 扫描到的每笔转入及处理结果，Amount为未乘入账倍数的系统金额
+type InboundTransfer struct {
+	ID          int64
+	Hash        string
+	LogIndex    int64
+	FromAddress string
+	ToAddress   string
+	CoinType    string
+	Value       string
+	Amount      int64
+	UserId      int64
+	BlockNumber int64
+	BlockHash   string
+	ProductId   int64 // 扫描时匹配的套餐
+	Disposition string
+	Resolution  string // 空未处理，location、balance、refunded
+	Remark      string
+	CreatedAt   time.Time
+}
+
+const (
+	InboundCredited        = "credited"
+	InboundPendingPair     = "pending_pair"     // 等待配对，配对后改为credited，开仓位失败时改为失败原因并继续重试
+	InboundUnknownSender   = "unknown_sender"   // 转入地址不属于任何用户
+	InboundBelowMinimum    = "below_minimum"    // 低于最低充值
+	InboundNoProduct       = "no_product"       // 没有匹配金额的套餐
+	InboundLocationRunning = "location_running" // 已有运行中的仓位
+	InboundError           = "error"
+)
+
+const (
+	InboundResolveLocation = "location" // 指定用户开仓位
+	InboundResolveBalance  = "balance"  // 入用户余额
+	InboundResolveRefunded = "refunded" // 已退回
+)
+
+type InboundTransferRepo interface {
+	CreateInboundTransfer(ctx context.Context, t *InboundTransfer) (*InboundTransfer, error)
+	// GetInboundTransfersByHash 一笔交易可能有多条转入，按log_index排序
+	GetInboundTransfersByHash(ctx context.Context, hash ...string) ([]*InboundTransfer, error)
+	GetInboundTransferById(ctx context.Context, id int64) (*InboundTransfer, error)
+	// GetInboundTransfers pending为1时只查未入账且未处理的
+	GetInboundTransfers(ctx context.Context, b *Pagination, disposition string, pending int64) ([]*InboundTransfer, error, int64)
+	// UpdateInboundTransferDisposition 按(hash, log_index)只更新结果仍为from的记录，没有记录不报错
+	UpdateInboundTransferDisposition(ctx context.Context, hash string, logIndex int64, from string, to string) error
+	// UpdateInboundTransferResolution 只更新未处理的记录
+	UpdateInboundTransferResolution(ctx context.Context, id int64, userId int64, resolution string, remark string) error
+}
+
+func (ruc *RecordUseCase) AdminInboundTransferList(ctx context.Context, req *v1.AdminInboundTransferListRequest) (*v1.AdminInboundTransferListReply, error) {
+	var (
+		transfers []*InboundTransfer
+		count     int64
+		err       error
+	)
+
+	res := &v1.AdminInboundTransferListReply{
+		Transfers: make([]*v1.AdminInboundTransferListReply_List, 0),
+	}
+
+	transfers, err, count = ruc.inboundTransferRepo.GetInboundTransfers(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Disposition, req.Pending)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	for _, v := range transfers {
+		res.Transfers = append(res.Transfers, &v1.AdminInboundTransferListReply_List{
+			Id:          v.ID,
+			Hash:        v.Hash,
+			LogIndex:    v.LogIndex,
+			FromAddress: v.FromAddress,
+			ToAddress:   v.ToAddress,
+			CoinType:    v.CoinType,
+			Value:       v.Value,
+			Amount:      fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			UserId:      v.UserId,
+			BlockNumber: v.BlockNumber,
+			Disposition: v.Disposition,
+			Resolution:  v.Resolution,
+			Remark:      v.Remark,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminInboundTransferResolve 未入账的转入人工处理，user_id为0时用原记录的用户
+func (ruc *RecordUseCase) AdminInboundTransferResolve(ctx context.Context, req *v1.AdminInboundTransferResolveRequest) (*v1.AdminInboundTransferResolveReply, error) {
+	var (
+		transfer    *InboundTransfer
+		existRecord []*EthUserRecord
+		token       *Token
+		err         error
+	)
+
+	res := &v1.AdminInboundTransferResolveReply{}
+
+	transfer, err = ruc.inboundTransferRepo.GetInboundTransferById(ctx, req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if InboundCredited == transfer.Disposition || InboundPendingPair == transfer.Disposition {
+		return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "该转入已入账")
+	}
+	if "" != transfer.Resolution {
+		return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "该转入已处理")
+	}
+
+	if InboundResolveRefunded == req.SendBody.Action {
+		return res, ruc.inboundTransferRepo.UpdateInboundTransferResolution(ctx, transfer.ID, transfer.UserId, InboundResolveRefunded, req.SendBody.Remark)
+	}
+
+	userId := req.SendBody.UserId
+	if 0 >= userId {
+		userId = transfer.UserId
+	}
+	if 0 >= userId {
+		return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "请指定用户")
+	}
+	if _, err = ruc.userRepo.GetUserById(ctx, userId); nil != err {
+		return res, err
+	}
+
+	// 入账记录按(hash, log_index)唯一，扫描之前按hash入账的记录整笔交易都算已入账
+	existRecord, err = ruc.ethUserRecordRepo.GetEthUserRecordsByHash(ctx, transfer.Hash)
+	if nil != err {
+		return res, err
+	}
+	for _, v := range existRecord {
+		if transfer.LogIndex == v.LogIndex && depositStatusPair == v.Status {
+			return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "该转入等待配对，会在下次扫描时重试")
+		}
+		if "" == v.BlockHash || transfer.LogIndex == v.LogIndex {
+			return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "该转入已有入账记录")
+		}
+	}
+
+	token, err = ruc.tokenRepo.GetTokenBySymbol(ctx, transfer.CoinType)
+	if nil != err {
+		return res, err
+	}
+
+	switch req.SendBody.Action {
+	case InboundResolveLocation:
+		relAmount := transfer.Amount * depositRate
+		chainAmount, err := token.ToChainAmount(relAmount)
+		if nil != err {
+			return res, errors.New(500, "INBOUND_TRANSFER_ERROR", err.Error())
+		}
+
+		record := &EthUserRecord{
+			UserId:      userId,
+			Hash:        transfer.Hash,
+			LogIndex:    transfer.LogIndex,
+			Status:      "success",
+			Type:        "deposit",
+			Amount:      chainAmount.String(),
+			RelAmount:   relAmount,
+			CoinType:    transfer.CoinType,
+			BlockNumber: transfer.BlockNumber,
+			BlockHash:   transfer.BlockHash,
+			ProductId:   transfer.ProductId,
+		}
+
+		// 开仓位和处理结果在同一事务，任一失败都回滚
+		return res, ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			if _, err = ruc.EthUserRecordHandle(ctx, record); nil != err {
+				return err
+			}
+			if InboundLocationRunning == record.Disposition {
+				return errors.New(500, "INBOUND_TRANSFER_ERROR", "用户有运行中的仓位")
+			}
+			if InboundCredited != record.Disposition {
+				return errors.New(500, "INBOUND_TRANSFER_ERROR", "开仓位失败")
+			}
+
+			return ruc.inboundTransferRepo.UpdateInboundTransferResolution(ctx, transfer.ID, userId, InboundResolveLocation, req.SendBody.Remark)
+		})
+	case InboundResolveBalance:
+		chainAmount, err := token.ToChainAmount(transfer.Amount)
+		if nil != err {
+			return res, errors.New(500, "INBOUND_TRANSFER_ERROR", err.Error())
+		}
+
+		return res, ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			_, err = ruc.userBalanceRepo.DepositBalance(ctx, userId, transfer.Amount, strings.ToLower(transfer.CoinType))
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+				UserId:      userId,
+				Hash:        transfer.Hash,
+				LogIndex:    transfer.LogIndex,
+				Status:      "success",
+				Type:        "deposit",
+				Amount:      chainAmount.String(),
+				CoinType:    transfer.CoinType,
+				BlockNumber: transfer.BlockNumber,
+				BlockHash:   transfer.BlockHash,
+			})
+			if nil != err {
+				return err
+			}
+
+			return ruc.inboundTransferRepo.UpdateInboundTransferResolution(ctx, transfer.ID, userId, InboundResolveBalance, req.SendBody.Remark)
+		})
+	}
+
+	return res, errors.New(500, "INBOUND_TRANSFER_ERROR", "处理方式错误")
+}
@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"time"
+)
+
+// Product 充值套餐，金额为充值代币的系统金额(未乘入账倍数)，比例都是百分比
+type Product struct {
+	ID                 int64
+	Name               string
+	MinAmount          int64
+	MaxAmount          int64 // 0不限
+	OutRate            int64 // 出局倍数
+	LocationRewardRate int64 // 每日分红比例
+	RewardRate         int64 // 分红入usdt的比例
+	CoinRewardRate     int64 // 分红入dhb的比例
+	StartTime          time.Time
+	EndTime            time.Time // 零值不限
+	Status             int64     // 1启用
+	CreatedAt          time.Time
+}
+
+type ProductRepo interface {
+	GetProducts(ctx context.Context) ([]*Product, error)
+	GetProductById(ctx context.Context, id int64) (*Product, error)
+	CreateProduct(ctx context.Context, p *Product) (*Product, error)
+	UpdateProduct(ctx context.Context, p *Product) error
+}
+
+// Active 启用且在售卖时间内
+func (p *Product) Active(now time.Time) bool {
+	if 1 != p.Status {
+		return false
+	}
+	if !p.StartTime.IsZero() && now.Before(p.StartTime) {
+		return false
+	}
+	if !p.EndTime.IsZero() && !now.Before(p.EndTime) {
+		return false
+	}
+	return true
+}
+
+// Match 金额是否在套餐范围内
+func (p *Product) Match(amount int64) bool {
+	return amount >= p.MinAmount && (0 == p.MaxAmount || amount <= p.MaxAmount)
+}
+
+// activeProducts 当前可用的套餐，按最低金额从高到低
+func activeProducts(products []*Product, now time.Time) []*Product {
+	res := make([]*Product, 0)
+	for _, v := range products {
+		if v.Active(now) {
+			res = append(res, v)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].MinAmount > res[j].MinAmount
+	})
+	return res
+}
+
+// matchProduct 优先用户选择的套餐，金额不符时按金额匹配最低金额最高的套餐
+func matchProduct(products []*Product, amount int64, selectId int64) *Product {
+	if 0 < selectId {
+		for _, v := range products {
+			if selectId == v.ID && v.Match(amount) {
+				return v
+			}
+		}
+	}
+	for _, v := range products {
+		if v.Match(amount) {
+			return v
+		}
+	}
+	return nil
+}
+
+func parseProductTime(s string) (time.Time, error) {
+	if "" == s {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02 15:04:05", s)
+	if nil != err {
+		return time.Time{}, errors.New(500, "PRODUCT_ERROR", "时间格式错误")
+	}
+	return t.Add(-8 * time.Hour), nil // 后台按东八区填写
+}
+
+func formatProductTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+}
+
+func (uuc *UserUseCase) AdminProductList(ctx context.Context, req *v1.AdminProductListRequest) (*v1.AdminProductListReply, error) {
+	products, err := uuc.productRepo.GetProducts(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminProductListReply{Products: make([]*v1.AdminProductListReply_List, 0)}
+	for _, v := range products {
+		res.Products = append(res.Products, &v1.AdminProductListReply_List{
+			Id:                 v.ID,
+			Name:               v.Name,
+			MinAmount:          fmt.Sprintf("%.2f", float64(v.MinAmount)/float64(10000000000)),
+			MaxAmount:          fmt.Sprintf("%.2f", float64(v.MaxAmount)/float64(10000000000)),
+			OutRate:            v.OutRate,
+			LocationRewardRate: v.LocationRewardRate,
+			RewardRate:         v.RewardRate,
+			CoinRewardRate:     v.CoinRewardRate,
+			StartTime:          formatProductTime(v.StartTime),
+			EndTime:            formatProductTime(v.EndTime),
+			Status:             v.Status,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminProductSave id为0时新增，否则修改
+func (uuc *UserUseCase) AdminProductSave(ctx context.Context, req *v1.AdminProductSaveRequest) (*v1.AdminProductSaveReply, error) {
+	var err error
+
+	res := &v1.AdminProductSaveReply{}
+
+	p := &Product{
+		ID:                 req.SendBody.Id,
+		Name:               req.SendBody.Name,
+		OutRate:            req.SendBody.OutRate,
+		LocationRewardRate: req.SendBody.LocationRewardRate,
+		RewardRate:         req.SendBody.RewardRate,
+		CoinRewardRate:     req.SendBody.CoinRewardRate,
+		Status:             req.SendBody.Status,
+	}
+	if "" == p.Name {
+		return res, errors.New(500, "PRODUCT_ERROR", "请填写套餐名称")
+	}
+	p.MinAmount, err = parseAmount(req.SendBody.MinAmount)
+	if nil != err {
+		return res, err
+	}
+	if "" != req.SendBody.MaxAmount {
+		p.MaxAmount, err = parseAmount(req.SendBody.MaxAmount)
+		if nil != err {
+			return res, err
+		}
+	}
+	if 0 >= p.MinAmount || (0 != p.MaxAmount && p.MaxAmount < p.MinAmount) {
+		return res, errors.New(500, "PRODUCT_ERROR", "金额范围错误")
+	}
+	if 0 >= p.OutRate || 0 > p.LocationRewardRate || 0 > p.RewardRate || 0 > p.CoinRewardRate {
+		return res, errors.New(500, "PRODUCT_ERROR", "比例错误")
+	}
+	p.StartTime, err = parseProductTime(req.SendBody.StartTime)
+	if nil != err {
+		return res, err
+	}
+	p.EndTime, err = parseProductTime(req.SendBody.EndTime)
+	if nil != err {
+		return res, err
+	}
+
+	if 0 == p.ID {
+		_, err = uuc.productRepo.CreateProduct(ctx, p)
+	} else {
+		err = uuc.productRepo.UpdateProduct(ctx, p)
+	}
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// ProductList 当前可选的套餐
+func (uuc *UserUseCase) ProductList(ctx context.Context, req *v1.ProductListRequest, user *User) (*v1.ProductListReply, error) {
+	var (
+		products       []*Product
+		depositAddress *DepositAddress
+		err            error
+	)
+
+	res := &v1.ProductListReply{Products: make([]*v1.ProductListReply_List, 0)}
+
+	products, err = uuc.productRepo.GetProducts(ctx)
+	if nil != err {
+		return res, err
+	}
+	depositAddress, err = uuc.GetDepositAddress(ctx, user.ID)
+	if nil == err {
+		res.SelectId = depositAddress.ProductId
+	}
+
+	for _, v := range activeProducts(products, time.Now().UTC()) {
+		res.Products = append(res.Products, &v1.ProductListReply_List{
+			Id:                 v.ID,
+			Name:               v.Name,
+			MinAmount:          fmt.Sprintf("%.2f", float64(v.MinAmount)/float64(10000000000)),
+			MaxAmount:          fmt.Sprintf("%.2f", float64(v.MaxAmount)/float64(10000000000)),
+			OutRate:            v.OutRate,
+			LocationRewardRate: v.LocationRewardRate,
+		})
+	}
+
+	return res, nil
+}
+
+// DepositProduct 选择下一笔充值的套餐，0为按金额匹配
+func (uuc *UserUseCase) DepositProduct(ctx context.Context, req *v1.DepositProductRequest, user *User) (*v1.DepositProductReply, error) {
+	var (
+		product *Product
+		err     error
+	)
+
+	res := &v1.DepositProductReply{}
+
+	if 0 < req.SendBody.ProductId {
+		product, err = uuc.productRepo.GetProductById(ctx, req.SendBody.ProductId)
+		if nil != err {
+			return res, err
+		}
+		if !product.Active(time.Now().UTC()) {
+			return res, errors.New(500, "PRODUCT_ERROR", "套餐不可选")
+		}
+	}
+
+	// 确保充值地址已生成
+	if _, err = uuc.GetDepositAddress(ctx, user.ID); nil != err {
+		return res, err
+	}
+	if err = uuc.depositAddressRepo.UpdateDepositAddressProduct(ctx, user.ID, req.SendBody.ProductId); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
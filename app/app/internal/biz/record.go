@@ -11,14 +11,25 @@ import (
 )
 
 type EthUserRecord struct {
-	ID        int64
-	UserId    int64
-	Hash      string
-	Status    string
-	Type      string
-	Amount    string
-	RelAmount int64
-	CoinType  string
+	ID              int64
+	UserId          int64
+	Hash            string
+	LogIndex        int64 // 交易内的log序号
+	Status          string
+	Type            string
+	Amount          string
+	RelAmount       int64
+	CoinType        string
+	BlockNumber     int64
+	BlockHash       string
+	Reorg           int64 // 0正常，1区块已回滚待处理，2已处理
+	LocationId      int64 // 开的仓位
+	BalanceRecordId int64 // 充值的余额记录
+	ProductId       int64 // 匹配的套餐
+	CreatedAt       time.Time
+	CoinAmount      int64   // 配对入账的dhb
+	PairIds         []int64 // 配对消耗的待配对记录
+	Disposition     string  // 入账处理结果
 }
 
 type Location struct {
@@ -44,6 +55,7 @@ type LocationNew struct {
 	OutRate           int64
 	StopCoin          int64
 	StopDate          time.Time
+	ProductId         int64 // 来源套餐，0为全局配置
 	CreatedAt         time.Time
 }
 
@@ -60,6 +72,15 @@ type RecordUseCase struct {
 	userBalanceRepo               UserBalanceRepo
 	userInfoRepo                  UserInfoRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	userRepo                      UserRepo
+	depositSource                 DepositSource
+	depositCursorRepo             DepositCursorRepo
+	tokenRepo                     TokenRepo
+	depositAddressRepo            DepositAddressRepo
+	withdrawChain                 WithdrawChain
+	inboundTransferRepo           InboundTransferRepo
+	productRepo                   ProductRepo
+	collectionAddress             string // 原充值收款地址，小写
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -67,6 +88,19 @@ type RecordUseCase struct {
 type EthUserRecordRepo interface {
 	GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
+	// GetEthUserRecordsByHash 一笔交易可能有多条记录
+	GetEthUserRecordsByHash(ctx context.Context, hash ...string) ([]*EthUserRecord, error)
+	// GetEthUserRecordsFromBlock fromBlock及之后未标记回滚的充值记录
+	GetEthUserRecordsFromBlock(ctx context.Context, fromBlock int64) ([]*EthUserRecord, error)
+	GetEthUserRecordsByReorg(ctx context.Context, b *Pagination, reorg int64) ([]*EthUserRecord, error, int64)
+	UpdateEthUserRecordBlock(ctx context.Context, id int64, blockNumber int64, blockHash string) error
+	// UpdateEthUserRecordReorg 只更新标记仍为from的记录
+	UpdateEthUserRecordReorg(ctx context.Context, id int64, from int64, to int64) error
+	GetEthUserRecordsByStatus(ctx context.Context, status string) ([]*EthUserRecord, error)
+	// UpdateEthUserRecordLocation 待配对记录入账后补充类型、仓位和余额记录
+	UpdateEthUserRecordLocation(ctx context.Context, id int64, recordType string, locationId int64, balanceRecordId int64) error
+	// UpdateEthUserRecordStatus 只更新状态仍为from的记录
+	UpdateEthUserRecordStatus(ctx context.Context, id int64, from string, to string) error
 }
 
 type LocationRepo interface {
@@ -99,6 +133,8 @@ type LocationRepo interface {
 	GetLocationsNewByUserId(ctx context.Context, userId int64) ([]*LocationNew, error)
 	UpdateLocationNew(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error
 	GetRunningLocations(ctx context.Context) ([]*LocationNew, error)
+	GetLocationNewById(ctx context.Context, id int64) (*LocationNew, error)
+	TopUpLocationNew(ctx context.Context, id int64, currentMax int64) error
 }
 
 func NewRecordUseCase(
@@ -109,8 +145,22 @@ func NewRecordUseCase(
 	userInfoRepo UserInfoRepo,
 	configRepo ConfigRepo,
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo,
+	userRepo UserRepo,
+	depositSource DepositSource,
+	depositCursorRepo DepositCursorRepo,
+	tokenRepo TokenRepo,
+	depositAddressRepo DepositAddressRepo,
+	withdrawChain WithdrawChain,
+	inboundTransferRepo InboundTransferRepo,
+	productRepo ProductRepo,
+	sweepConfig *SweepConfig,
 	tx Transaction,
 	logger log.Logger) *RecordUseCase {
+	var collectionAddress string
+	if nil != sweepConfig {
+		collectionAddress = strings.ToLower(sweepConfig.CollectionAddress)
+	}
+
 	return &RecordUseCase{
 		ethUserRecordRepo:             ethUserRecordRepo,
 		locationRepo:                  locationRepo,
@@ -119,6 +169,15 @@ func NewRecordUseCase(
 		userBalanceRepo:               userBalanceRepo,
 		userCurrentMonthRecommendRepo: userCurrentMonthRecommendRepo,
 		userInfoRepo:                  userInfoRepo,
+		userRepo:                      userRepo,
+		depositSource:                 depositSource,
+		tokenRepo:                     tokenRepo,
+		depositAddressRepo:            depositAddressRepo,
+		withdrawChain:                 withdrawChain,
+		inboundTransferRepo:           inboundTransferRepo,
+		productRepo:                   productRepo,
+		depositCursorRepo:             depositCursorRepo,
+		collectionAddress:             collectionAddress,
 		tx:                            tx,
 		log:                           log.NewHelper(logger),
 	}
@@ -146,9 +205,11 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 		recommendAreaTwo   int64
 		recommendAreaThree int64
 		recommendAreaFour  int64
+		locationTopUp      int64
+		lastErr            error // 入账失败的错误，其他记录继续处理
 	)
 	// 配置
-	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "time_again", "out_rate", "coin_price", "reward_rate", "coin_reward_rate", "recommend_area_one", "recommend_area_two", "recommend_area_three", "recommend_area_four")
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "time_again", "out_rate", "coin_price", "reward_rate", "coin_reward_rate", "recommend_area_one", "recommend_area_two", "recommend_area_three", "recommend_area_four", "location_top_up")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "recommend_need" == vConfig.KeyName {
@@ -171,6 +232,8 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 				recommendAreaThree, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			} else if "recommend_area_four" == vConfig.KeyName {
 				recommendAreaFour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "location_top_up" == vConfig.KeyName {
+				locationTopUp, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			}
 		}
 	}
@@ -190,33 +253,50 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			myUserRecommendUserLocationsLast []*LocationNew
 			myLastStopLocations              []*LocationNew
 			myLocations                      []*LocationNew
+			runningLocation                  *LocationNew
 			tmpRecommendUserIds              []string
-			dhbAmount                        int64
+			dhbAmount                        = v.CoinAmount
 			err                              error
 		)
 
 		// 获取当前用户的占位信息，已经有运行中的跳过
+		v.Disposition = InboundError // 未入账时的结果
 		myLocations, err = ruc.locationRepo.GetLocationsNewByUserId(ctx, v.UserId)
 		if nil == myLocations { // 查询异常跳过本次循环
 			continue
 		}
 		if 0 < len(myLocations) { // 也代表复投
-			tmpStatusRunning := false
 			for _, vMyLocations := range myLocations {
 				if "running" == vMyLocations.Status {
-					tmpStatusRunning = true
+					runningLocation = vMyLocations
 					break
 				}
 			}
 
-			if tmpStatusRunning { // 有运行中直接跳过本次循环
+			if nil != runningLocation && 1 != locationTopUp { // 有运行中且未开启追加直接跳过本次循环
+				v.Disposition = InboundLocationRunning
+				continue
+			}
+		}
+
+		// 套餐的出局倍数
+		locationOutRate := outRate
+		if 0 < v.ProductId {
+			product, err := ruc.productRepo.GetProductById(ctx, v.ProductId)
+			if nil != err {
+				lastErr = err
 				continue
 			}
+			locationOutRate = product.OutRate
 		}
 
 		// 金额
-		locationCurrentMax = v.RelAmount * outRate / 100
+		locationCurrentMax = v.RelAmount * locationOutRate / 100
 		currentValue = v.RelAmount
+		if nil != runningLocation { // 追加按原仓位的出局倍数
+			locationCurrentMax = v.RelAmount * runningLocation.OutRate / 100
+			v.Type = "top_up"
+		}
 
 		// 推荐人
 		userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, v.UserId)
@@ -233,8 +313,10 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			myUserRecommendUserInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, myUserRecommendUserId)
 		}
 
-		// 冻结
-		myLastStopLocations, err = ruc.locationRepo.GetMyStopLocationsLast(ctx, v.UserId)
+		// 冻结，追加时不清算
+		if nil == runningLocation {
+			myLastStopLocations, err = ruc.locationRepo.GetMyStopLocationsLast(ctx, v.UserId)
+		}
 		now := time.Now().UTC().Add(8 * time.Hour)
 		if nil != myLastStopLocations {
 			for _, vMyLastStopLocations := range myLastStopLocations {
@@ -245,22 +327,31 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 		}
 
 		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpLocationStatus := "running"
-			var tmpStopDate time.Time
-			if locationCurrent >= locationCurrentMax {
-				tmpLocationStatus = "stop"
-				tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
-			}
-			currentLocationNew, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{ // 占位
-				UserId:     v.UserId,
-				Status:     tmpLocationStatus,
-				Current:    locationCurrent,
-				CurrentMax: locationCurrentMax,
-				OutRate:    outRate,
-				StopDate:   tmpStopDate,
-			})
-			if nil != err {
-				return err
+			if nil != runningLocation { // 追加到运行中的仓位
+				err = ruc.locationRepo.TopUpLocationNew(ctx, runningLocation.ID, locationCurrentMax)
+				if nil != err {
+					return err
+				}
+				currentLocationNew = runningLocation
+			} else {
+				tmpLocationStatus := "running"
+				var tmpStopDate time.Time
+				if locationCurrent >= locationCurrentMax {
+					tmpLocationStatus = "stop"
+					tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
+				}
+				currentLocationNew, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{ // 占位
+					UserId:     v.UserId,
+					Status:     tmpLocationStatus,
+					Current:    locationCurrent,
+					CurrentMax: locationCurrentMax,
+					OutRate:    locationOutRate,
+					StopDate:   tmpStopDate,
+					ProductId:  v.ProductId,
+				})
+				if nil != err {
+					return err
+				}
 			}
 
 			// 推荐人
@@ -434,10 +525,15 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 				}
 			}
 
-			_, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, currentValue, dhbAmount) // 充值
+			if nil != runningLocation {
+				v.BalanceRecordId, err = ruc.userBalanceRepo.TopUp(ctx, v.UserId, currentValue, dhbAmount) // 追加
+			} else {
+				v.BalanceRecordId, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, currentValue, dhbAmount) // 充值
+			}
 			if nil != err {
 				return err
 			}
+			v.LocationId = currentLocationNew.ID
 
 			// 清算冻结
 			if nil != myLastStopLocations {
@@ -469,31 +565,57 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			//	return err
 			//}
 
-			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:     v.Hash,
-				UserId:   v.UserId,
-				Status:   v.Status,
-				Type:     v.Type,
-				Amount:   v.Amount,
-				CoinType: v.CoinType,
-			})
+			// 待配对的记录已存在，改为入账状态
+			if 0 < v.ID {
+				err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, depositStatusPair, v.Status)
+				if nil != err {
+					return err
+				}
+				err = ruc.ethUserRecordRepo.UpdateEthUserRecordLocation(ctx, v.ID, v.Type, v.LocationId, v.BalanceRecordId)
+			} else if "" != v.Hash { // 后台追加没有链上交易，不写充值记录
+				_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+					Hash:            v.Hash,
+					LogIndex:        v.LogIndex,
+					UserId:          v.UserId,
+					Status:          v.Status,
+					Type:            v.Type,
+					Amount:          v.Amount,
+					CoinType:        v.CoinType,
+					BlockNumber:     v.BlockNumber,
+					BlockHash:       v.BlockHash,
+					LocationId:      v.LocationId,
+					BalanceRecordId: v.BalanceRecordId,
+					ProductId:       v.ProductId,
+				})
+			}
 			if nil != err {
 				return err
 			}
 
+			for _, vPairId := range v.PairIds {
+				if err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, vPairId, depositStatusPair, v.Status); nil != err {
+					return err
+				}
+			}
+
 			return nil
 		}); nil != err {
+			lastErr = err
 			continue
 		}
+
+		v.Disposition = InboundCredited
 	}
 
+	if nil != lastErr {
+		return false, lastErr
+	}
 	return true, nil
 }
 
 func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64, amount int64) (bool, error) {
 
 	var (
-		currentLocation         *LocationNew
 		myLastStopLocations     []*LocationNew
 		stopCoin                int64
 		stopUsdt                int64
@@ -510,9 +632,11 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 		rewardRate              int64
 		outRate                 int64
 		timeAgain               int64
+		locationTopUp           int64
+		runningLocation         *LocationNew
 	)
 	// 配置
-	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "time_again", "out_rate", "coin_price", "reward_rate", "coin_reward_rate")
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "time_again", "out_rate", "coin_price", "reward_rate", "coin_reward_rate", "location_top_up")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "time_again" == vConfig.KeyName {
@@ -525,6 +649,8 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 				coinRewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			} else if "reward_rate" == vConfig.KeyName {
 				rewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "location_top_up" == vConfig.KeyName {
+				locationTopUp, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			}
 		}
 	}
@@ -535,19 +661,22 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 		return false, errors.New(500, "ERROR", "输入金额错误，重试")
 	}
 	if 0 < len(myLocations) { // 也代表复投
-		tmpStatusRunning := false
 		for _, vMyLocations := range myLocations {
 			if "running" == vMyLocations.Status {
-				tmpStatusRunning = true
+				runningLocation = vMyLocations
 				break
 			}
 		}
 
-		if tmpStatusRunning { // 有运行中直接跳过本次循环
+		if nil != runningLocation && 1 != locationTopUp { // 有运行中且未开启追加直接跳过本次循环
 			return false, errors.New(500, "ERROR", "已存在运行中位置信息")
 		}
 	}
 
+	if nil != runningLocation {
+		return ruc.adminLocationTopUp(ctx, userId, amount)
+	}
+
 	// 冻结
 	myLastStopLocations, err = ruc.locationRepo.GetMyStopLocationsLast(ctx, userId)
 	now := time.Now().UTC().Add(8 * time.Hour)
@@ -600,7 +729,7 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 			tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
 		}
 
-		currentLocation, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{ // 占位
+		_, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{ // 占位
 			UserId:     userId,
 			Status:     tmpLocationStatus,
 			Current:    locationCurrent,
@@ -674,6 +803,25 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 	return true, nil
 }
 
+// adminLocationTopUp 后台给运行中的仓位追加，和充值追加走同一流程：按原仓位出局倍数加额度、直推奖励、top_up余额记录、区业绩
+func (ruc *RecordUseCase) adminLocationTopUp(ctx context.Context, userId int64, amount int64) (bool, error) {
+	record := &EthUserRecord{
+		UserId:    userId,
+		Status:    "success",
+		Type:      "deposit",
+		RelAmount: amount * 10000000000,
+		CoinType:  "USDT",
+	}
+	if _, err := ruc.EthUserRecordHandle(ctx, record); nil != err {
+		return false, err
+	}
+	if InboundCredited != record.Disposition {
+		return false, errors.New(500, "ERROR", "错误，重试")
+	}
+
+	return true, nil
+}
+
 func (ruc *RecordUseCase) LockSystem(ctx context.Context, req *v1.LockSystemRequest) (*v1.LockSystemReply, error) {
 	_, _ = ruc.locationRepo.LockGlobalLocation(ctx)
 	return nil, nil
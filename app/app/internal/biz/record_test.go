@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+	"time"
+)
+
+type testLocationRepo struct {
+	LocationRepo
+	locations map[int64][]*LocationNew
+	topUps    map[int64]int64
+	currents  map[int64]int64
+}
+
+func (r *testLocationRepo) GetLocationsNewByUserId(ctx context.Context, userId int64) ([]*LocationNew, error) {
+	return r.locations[userId], nil
+}
+
+func (r *testLocationRepo) GetLockGlobalLocation(ctx context.Context) (*GlobalLock, error) {
+	return &GlobalLock{Status: 1}, nil
+}
+
+func (r *testLocationRepo) GetMyStopLocationsLast(ctx context.Context, userId int64) ([]*LocationNew, error) {
+	return nil, nil
+}
+
+func (r *testLocationRepo) CreateLocationNew(ctx context.Context, l *LocationNew) (*LocationNew, error) {
+	l.ID = int64(100 + len(r.locations[l.UserId]))
+	r.locations[l.UserId] = append(r.locations[l.UserId], l)
+	return l, nil
+}
+
+func (r *testLocationRepo) TopUpLocationNew(ctx context.Context, id int64, currentMax int64) error {
+	r.topUps[id] += currentMax
+	return nil
+}
+
+func (r *testLocationRepo) UpdateLocationNew(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error {
+	r.currents[id] += current
+	return nil
+}
+
+type testUserRecommendRepo struct {
+	UserRecommendRepo
+	recommends map[int64]*UserRecommend
+}
+
+func (r *testUserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error) {
+	return r.recommends[userId], nil
+}
+
+func (r *testUserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code string) ([]*UserRecommend, error) {
+	return []*UserRecommend{}, nil
+}
+
+func (r *testUserRecommendRepo) UpdateUserAreaAmount(ctx context.Context, userId int64, amount int64) (bool, error) {
+	return true, nil
+}
+
+func (r *testUserRecommendRepo) UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) (bool, error) {
+	return true, nil
+}
+
+func (r *testUserRecommendRepo) UpdateUserAreaLevelUp(ctx context.Context, userId int64, level int64) (bool, error) {
+	return true, nil
+}
+
+type testUserInfoRepo struct {
+	UserInfoRepo
+}
+
+func (r *testUserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (*UserInfo, error) {
+	return &UserInfo{UserId: userId}, nil
+}
+
+type testLocationBalanceRepo struct {
+	UserBalanceRepo
+	rewards map[int64]int64
+	topUps  map[int64]int64
+}
+
+func (r *testLocationBalanceRepo) NormalRecommendReward(ctx context.Context, userId int64, rewardAmount int64, amount int64, amountDhb int64, locationId int64, status string) (int64, error) {
+	r.rewards[userId] += rewardAmount
+	return 1, nil
+}
+
+func (r *testLocationBalanceRepo) Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	r.topUps[userId] += amount
+	return 1, nil
+}
+
+func (r *testLocationBalanceRepo) TopUp(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	r.topUps[userId] += amount
+	return 1, nil
+}
+
+func TestAdminLocationTopUp(t *testing.T) {
+	locations := &testLocationRepo{
+		locations: map[int64][]*LocationNew{
+			2: {{ID: 20, UserId: 2, Status: "running", CurrentMax: 30000000000000, OutRate: 300}},
+			1: {{ID: 10, UserId: 1, Status: "running", CurrentMax: 30000000000000, OutRate: 300}},
+		},
+		topUps:   make(map[int64]int64, 0),
+		currents: make(map[int64]int64, 0),
+	}
+	balances := &testLocationBalanceRepo{rewards: make(map[int64]int64, 0), topUps: make(map[int64]int64, 0)}
+	records := &testEthUserRecordRepo{}
+	ruc := &RecordUseCase{
+		configRepo: &testConfigRepo{values: map[string]string{
+			"location_top_up":  "1",
+			"recommend_need":   "10",
+			"out_rate":         "200",
+			"reward_rate":      "100",
+			"coin_reward_rate": "0",
+			"coin_price":       "1000",
+		}},
+		locationRepo:      locations,
+		userRecommendRepo: &testUserRecommendRepo{recommends: map[int64]*UserRecommend{1: {UserId: 1}, 2: {UserId: 2, RecommendCode: "D1"}}},
+		userInfoRepo:      &testUserInfoRepo{},
+		userBalanceRepo:   balances,
+		ethUserRecordRepo: records,
+		tx:                &testTx{},
+		log:               log.NewHelper(log.DefaultLogger),
+	}
+
+	if _, err := ruc.AdminLocationInsert(context.Background(), 2, 100); nil != err {
+		t.Fatal(err)
+	}
+
+	// 按原仓位的出局倍数(百分比)追加额度
+	if 3000000000000 != locations.topUps[20] {
+		t.Fatalf("top up %d, want 3000000000000", locations.topUps[20])
+	}
+	// top_up余额记录
+	if 1000000000000 != balances.topUps[2] {
+		t.Fatalf("top up balance %d, want 1000000000000", balances.topUps[2])
+	}
+	// 直推奖励
+	if 100000000000 != balances.rewards[1] || 100000000000 != locations.currents[10] {
+		t.Fatalf("recommend reward %d current %d, want 100000000000", balances.rewards[1], locations.currents[10])
+	}
+	// 没有链上交易不写充值记录
+	if 0 != len(records.records) {
+		t.Fatalf("records %d, want 0", len(records.records))
+	}
+}
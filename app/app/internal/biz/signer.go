@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Signer 出款钱包签名，私钥不出现在代码和配置中
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// SweepSigner 用派生索引对应的充值地址私钥签名，只用于归集
+type SweepSigner interface {
+	SignSweepTx(ctx context.Context, index int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// transferMethodId erc20 transfer(address,uint256)
+var transferMethodId = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+
+// GasSigner 补gas交易带上收款充值地址的派生索引，签名服务据此校验收款地址
+type GasSigner interface {
+	SignGasTx(ctx context.Context, index int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// SignUsage 签名服务按(钱包, nonce, 代币)记录的出款金额，同一nonce的加速、取消和重新签名只按最大金额计一次
+type SignUsage struct {
+	ID          int64
+	Wallet      string // 小写地址
+	Nonce       int64
+	Token       string // 小写合约地址，主币为空
+	Destination string
+	Amount      *big.Int
+	Day         string // 首次签名的日期，东八区
+}
+
+// SignPolicyRepo 签名服务的出款记录和用户地址，使用签名服务自己的数据库账号
+type SignPolicyRepo interface {
+	// GetSignUsage 不存在时返回nil
+	GetSignUsage(ctx context.Context, wallet string, nonce int64, token string) (*SignUsage, error)
+	GetSignUsagesByDay(ctx context.Context, token string, day string) ([]*SignUsage, error)
+	// SaveSignUsage ID为0时新增，否则修改金额和收款地址
+	SaveSignUsage(ctx context.Context, u *SignUsage) error
+	// IsUserAddress 是否为注册用户的钱包地址
+	IsUserAddress(ctx context.Context, address string) (bool, error)
+}
+
+// SignPolicyOption 签名服务的出款规则配置
+type SignPolicyOption struct {
+	ChainId           int64
+	DailyLimits       map[common.Address]*big.Int // 允许出款的代币和每日上限，必须大于0
+	AllowDestinations []common.Address            // 用户钱包地址之外允许的收款地址
+	GasFundingMax     *big.Int                    // 补gas单笔上限，为nil时不允许补gas
+	GasFundingDaily   *big.Int                    // 补gas每日上限，为nil时不允许补gas
+	Collection        common.Address              // 归集地址
+}
+
+// SignPolicy 签名服务的出款规则：链、代币、收款地址白名单和持久化的每日金额
+type SignPolicy struct {
+	chainId           *big.Int
+	self              common.Address
+	dailyLimits       map[common.Address]*big.Int
+	allowDestinations map[common.Address]bool
+	gasFundingMax     *big.Int
+	gasFundingDaily   *big.Int
+	collection        common.Address
+	deriver           AddressDeriver // 补gas时由派生索引算出充值地址，为nil时不允许补gas
+	repo              SignPolicyRepo
+
+	mu sync.Mutex // 额度检查和记录串行执行
+}
+
+func NewSignPolicy(o *SignPolicyOption, self common.Address, deriver AddressDeriver, repo SignPolicyRepo) *SignPolicy {
+	p := &SignPolicy{
+		chainId:           big.NewInt(o.ChainId),
+		self:              self,
+		dailyLimits:       o.DailyLimits,
+		allowDestinations: make(map[common.Address]bool, 0),
+		gasFundingMax:     o.GasFundingMax,
+		gasFundingDaily:   o.GasFundingDaily,
+		collection:        o.Collection,
+		deriver:           deriver,
+		repo:              repo,
+	}
+	for _, v := range o.AllowDestinations {
+		p.allowDestinations[v] = true
+	}
+	return p
+}
+
+// Allow 校验交易，通过后记录出款金额，depositIndex为补gas时收款充值地址的派生索引
+func (p *SignPolicy) Allow(ctx context.Context, tx *types.Transaction, chainId *big.Int, depositIndex int64) error {
+	if nil == chainId || 0 != p.chainId.Cmp(chainId) {
+		return errors.New(403, "SIGN_POLICY_ERROR", "链id不允许")
+	}
+
+	if nil == tx.To() {
+		return errors.New(403, "SIGN_POLICY_ERROR", "不允许创建合约")
+	}
+
+	// 无数据的主币转账：转给自己0是取消交易，转给其他地址只能是给充值地址补gas
+	if 0 == len(tx.Data()) {
+		if p.self == *tx.To() && 0 == tx.Value().Sign() {
+			return nil
+		}
+		if nil == p.gasFundingMax || nil == p.gasFundingDaily || nil == p.deriver || 0 >= tx.Value().Sign() || 0 < tx.Value().Cmp(p.gasFundingMax) {
+			return errors.New(403, "SIGN_POLICY_ERROR", "不允许转账主币")
+		}
+		if 0 >= depositIndex {
+			return errors.New(403, "SIGN_POLICY_ERROR", "只能给充值地址补gas")
+		}
+		address, err := p.deriver.DeriveAddress(depositIndex)
+		if nil != err || !strings.EqualFold(address, tx.To().Hex()) {
+			return errors.New(403, "SIGN_POLICY_ERROR", "只能给充值地址补gas")
+		}
+
+		return p.use(ctx, tx.Nonce(), "", *tx.To(), tx.Value(), p.gasFundingDaily)
+	}
+
+	token, destination, amount, err := p.tokenTransfer(tx)
+	if nil != err {
+		return err
+	}
+	limit := p.dailyLimits[token]
+	if nil == limit || 0 >= limit.Sign() { // 未配置上限的不允许
+		return errors.New(403, "SIGN_POLICY_ERROR", "代币未配置每日出款上限")
+	}
+	if (common.Address{}) == destination {
+		return errors.New(403, "SIGN_POLICY_ERROR", "收款地址不允许")
+	}
+	if !p.allowDestinations[destination] {
+		ok, err := p.repo.IsUserAddress(ctx, destination.Hex())
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(403, "SIGN_POLICY_ERROR", "收款地址不是用户地址")
+		}
+	}
+
+	return p.use(ctx, tx.Nonce(), strings.ToLower(token.Hex()), destination, amount, limit)
+}
+
+// use 计入当日出款金额，同(钱包, nonce, 代币)已签过的只计超出部分
+func (p *SignPolicy) use(ctx context.Context, nonce uint64, token string, destination common.Address, amount *big.Int, limit *big.Int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wallet := strings.ToLower(p.self.Hex())
+	day := time.Now().UTC().Add(8 * time.Hour).Format("2006-01-02")
+
+	usage, err := p.repo.GetSignUsage(ctx, wallet, int64(nonce), token)
+	if nil != err {
+		return err
+	}
+	add := new(big.Int).Set(amount)
+	if nil != usage {
+		if 0 <= usage.Amount.Cmp(amount) { // 加速或重签，已计过
+			return nil
+		}
+		add.Sub(amount, usage.Amount)
+	}
+
+	usages, err := p.repo.GetSignUsagesByDay(ctx, token, day)
+	if nil != err {
+		return err
+	}
+	total := new(big.Int).Set(add)
+	for _, v := range usages {
+		total.Add(total, v.Amount)
+	}
+	if 0 < total.Cmp(limit) {
+		return errors.New(403, "SIGN_POLICY_ERROR", "超过每日出款上限")
+	}
+
+	if nil == usage {
+		usage = &SignUsage{Wallet: wallet, Nonce: int64(nonce), Token: token, Day: day}
+	}
+	usage.Destination = strings.ToLower(destination.Hex())
+	usage.Amount = amount
+	return p.repo.SaveSignUsage(ctx, usage)
+}
+
+// AllowSweep 归集交易只能是允许的代币全部转入归集地址，不计入出款金额
+func (p *SignPolicy) AllowSweep(tx *types.Transaction, chainId *big.Int) error {
+	if nil == chainId || 0 != p.chainId.Cmp(chainId) {
+		return errors.New(403, "SIGN_POLICY_ERROR", "链id不允许")
+	}
+	if nil == tx.To() {
+		return errors.New(403, "SIGN_POLICY_ERROR", "不允许创建合约")
+	}
+
+	_, destination, _, err := p.tokenTransfer(tx)
+	if nil != err {
+		return err
+	}
+	if (common.Address{}) == p.collection || p.collection != destination {
+		return errors.New(403, "SIGN_POLICY_ERROR", "归集地址不允许")
+	}
+
+	return nil
+}
+
+// tokenTransfer 解析允许的代币的transfer调用
+func (p *SignPolicy) tokenTransfer(tx *types.Transaction) (common.Address, common.Address, *big.Int, error) {
+	token := *tx.To()
+	if _, ok := p.dailyLimits[token]; !ok {
+		return token, common.Address{}, nil, errors.New(403, "SIGN_POLICY_ERROR", "代币不允许")
+	}
+	if 0 != tx.Value().Sign() {
+		return token, common.Address{}, nil, errors.New(403, "SIGN_POLICY_ERROR", "不允许转账主币")
+	}
+
+	data := tx.Data()
+	if 68 != len(data) || !bytes.Equal(transferMethodId, data[:4]) {
+		return token, common.Address{}, nil, errors.New(403, "SIGN_POLICY_ERROR", "只允许代币转账")
+	}
+
+	return token, common.BytesToAddress(data[16:36]), new(big.Int).SetBytes(data[36:68]), nil
+}
@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type NonceRepo interface {
+	CreateNonce(ctx context.Context, nonce string, address string, ttl time.Duration) error
+	GetNonce(ctx context.Context, nonce string) (string, error)
+	UseNonce(ctx context.Context, nonce string, address string) (bool, error)
+}
+
+// SiweOption EIP-4361 登录消息中的站点信息
+type SiweOption struct {
+	Domain   string
+	Uri      string
+	ChainId  int64
+	NonceTtl time.Duration
+}
+
+const siweStatement = "Sign in to DHB."
+
+// CreateSiweMessage 生成一次性nonce和待签名的登录消息
+func (uuc *UserUseCase) CreateSiweMessage(ctx context.Context, address string, o *SiweOption) (string, string, error) {
+	if !common.IsHexAddress(address) {
+		return "", "", errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); nil != err {
+		return "", "", errors.New(500, "AUTHORIZE_ERROR", "生成nonce失败")
+	}
+	nonce := hex.EncodeToString(b)
+
+	ttl := o.NonceTtl
+	if 0 >= ttl {
+		ttl = 5 * time.Minute
+	}
+	if err := uuc.nonceRepo.CreateNonce(ctx, nonce, strings.ToLower(address), ttl); nil != err {
+		return "", "", err
+	}
+
+	now := time.Now().UTC()
+	message := fmt.Sprintf("%s wants you to sign in with your Ethereum account:\n%s\n\n%s\n\nURI: %s\nVersion: 1\nChain ID: %d\nNonce: %s\nIssued At: %s\nExpiration Time: %s",
+		o.Domain,
+		common.HexToAddress(address).Hex(),
+		siweStatement,
+		o.Uri,
+		o.ChainId,
+		nonce,
+		now.Format(time.RFC3339),
+		now.Add(ttl).Format(time.RFC3339),
+	)
+
+	return nonce, message, nil
+}
+
+// VerifySiweMessage 校验登录消息内容和签名，签名通过后nonce才失效，避免他人用错误签名消耗用户的nonce
+func (uuc *UserUseCase) VerifySiweMessage(ctx context.Context, address string, message string, signature string, o *SiweOption) error {
+	lines := strings.Split(message, "\n")
+	if 2 > len(lines) || o.Domain+" wants you to sign in with your Ethereum account:" != lines[0] {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名消息格式错误")
+	}
+	if !strings.EqualFold(address, lines[1]) {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名消息地址不一致")
+	}
+
+	fields := make(map[string]string, 0)
+	for _, line := range lines[2:] {
+		if i := strings.Index(line, ": "); 0 < i {
+			fields[line[:i]] = line[i+2:]
+		}
+	}
+
+	if o.Uri != fields["URI"] || "1" != fields["Version"] || strconv.FormatInt(o.ChainId, 10) != fields["Chain ID"] {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名消息站点信息错误")
+	}
+	if expirationTime, ok := fields["Expiration Time"]; ok {
+		t, err := time.Parse(time.RFC3339, expirationTime)
+		if nil != err || t.Before(time.Now().UTC()) {
+			return errors.New(500, "AUTHORIZE_ERROR", "签名消息已过期")
+		}
+	}
+
+	nonceAddress, err := uuc.nonceRepo.GetNonce(ctx, fields["Nonce"])
+	if nil != err {
+		return errors.New(500, "AUTHORIZE_ERROR", "nonce无效")
+	}
+	if !strings.EqualFold(address, nonceAddress) {
+		return errors.New(500, "AUTHORIZE_ERROR", "nonce无效")
+	}
+
+	sig, err := hexutil.Decode(signature)
+	if nil != err || 65 != len(sig) {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名格式错误")
+	}
+	if 27 <= sig[64] { // 钱包返回的v为27/28
+		sig[64] -= 27
+	}
+
+	// personal_sign 规则的消息hash
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	pub, err := crypto.SigToPub(hash, sig)
+	if nil != err {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名验证失败")
+	}
+	if !strings.EqualFold(address, crypto.PubkeyToAddress(*pub).Hex()) {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名验证失败")
+	}
+
+	// 签名通过后删除，并发登录只有一个能删除成功
+	used, err := uuc.nonceRepo.UseNonce(ctx, fields["Nonce"], nonceAddress)
+	if nil != err {
+		return err
+	}
+	if !used {
+		return errors.New(500, "AUTHORIZE_ERROR", "nonce无效")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"testing"
+	"time"
+)
+
+type testNonceRepo struct {
+	nonces map[string]string
+}
+
+func (r *testNonceRepo) CreateNonce(ctx context.Context, nonce string, address string, ttl time.Duration) error {
+	r.nonces[nonce] = address
+	return nil
+}
+
+func (r *testNonceRepo) GetNonce(ctx context.Context, nonce string) (string, error) {
+	address, ok := r.nonces[nonce]
+	if !ok {
+		return "", errors.NotFound("NONCE_NOT_FOUND", "nonce not found")
+	}
+	return address, nil
+}
+
+func (r *testNonceRepo) UseNonce(ctx context.Context, nonce string, address string) (bool, error) {
+	if v, ok := r.nonces[nonce]; !ok || address != v {
+		return false, nil
+	}
+	delete(r.nonces, nonce)
+	return true, nil
+}
+
+func TestVerifySiweMessageKeepsNonceOnBadSignature(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	nonces := &testNonceRepo{nonces: make(map[string]string, 0)}
+	uuc := &UserUseCase{nonceRepo: nonces}
+	o := &SiweOption{Domain: "dhb.test", Uri: "https://dhb.test", ChainId: 56}
+	ctx := context.Background()
+
+	nonce, message, err := uuc.CreateSiweMessage(ctx, address, o)
+	if nil != err {
+		t.Fatal(err)
+	}
+	sign := func(k *ecdsa.PrivateKey) string {
+		hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+		sig, err := crypto.Sign(hash, k)
+		if nil != err {
+			t.Fatal(err)
+		}
+		sig[64] += 27
+		return hexutil.Encode(sig)
+	}
+
+	// 别人的签名不能消耗nonce
+	if err = uuc.VerifySiweMessage(ctx, address, message, sign(other), o); nil == err {
+		t.Fatal("signature of another key accepted")
+	}
+	if _, ok := nonces.nonces[nonce]; !ok {
+		t.Fatal("nonce consumed by a bad signature")
+	}
+
+	if err = uuc.VerifySiweMessage(ctx, address, message, sign(key), o); nil != err {
+		t.Fatal(err)
+	}
+	if _, ok := nonces.nonces[nonce]; ok {
+		t.Fatal("nonce not consumed after login")
+	}
+	if err = uuc.VerifySiweMessage(ctx, address, message, sign(key), o); nil == err {
+		t.Fatal("nonce reused")
+	}
+}
@@ -0,0 +1,384 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SweepStatusPending  = "pending"  // 待补gas
+	SweepStatusFunding  = "funding"  // 补gas交易已发出
+	SweepStatusFunded   = "funded"   // gas足够，待发归集交易
+	SweepStatusSweeping = "sweeping" // 归集交易已发出
+	SweepStatusDone     = "done"
+	SweepStatusFailed   = "failed"
+)
+
+// sweepGasLimit 归集交易的gas上限
+const sweepGasLimit = 100000
+
+// Sweep 一个充值地址一种代币的一次归集，每一步都先记录再上链，中断后按状态继续
+type Sweep struct {
+	ID         int64
+	Address    string
+	PathIndex  int64
+	Symbol     string
+	Amount     string // 归集数量，链上精度
+	GasPrice   string
+	FundTxHash string
+	TxHash     string
+	Nonce      int64
+	Retry      int64
+	Status     string
+	Remark     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type SweepRepo interface {
+	CreateSweep(ctx context.Context, s *Sweep) (*Sweep, error)
+	// UpdateSweep 只更新状态仍为from的记录，状态已被修改时返回错误
+	UpdateSweep(ctx context.Context, s *Sweep, from string) error
+	GetSweepsByStatus(ctx context.Context, status ...string) ([]*Sweep, error)
+}
+
+// SweepConfig 归集目标地址，取链配置的收款地址
+type SweepConfig struct {
+	CollectionAddress string
+}
+
+// sweepSettings 归集任务的配置
+type sweepSettings struct {
+	chainId        *big.Int
+	timeoutSeconds int64
+	maxRetry       int64
+}
+
+// AdminSweep 继续未完成的归集，再把代币余额超过阈值的充值地址加入归集
+func (uuc *UserUseCase) AdminSweep(ctx context.Context, req *v1.AdminSweepRequest) (*v1.AdminSweepReply, error) {
+	var (
+		configs          []*Config
+		minAmount        int64 = 100
+		settings               = &sweepSettings{timeoutSeconds: 1800, maxRetry: 3}
+		tokenList        []*Token
+		sweeps           []*Sweep
+		depositAddresses map[string]*DepositAddress
+		err              error
+	)
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "sweep_min_amount", "sweep_timeout_seconds", "sweep_max_retry")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "sweep_min_amount" == vConfig.KeyName {
+				minAmount, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "sweep_timeout_seconds" == vConfig.KeyName {
+				settings.timeoutSeconds, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "sweep_max_retry" == vConfig.KeyName {
+				settings.maxRetry, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	if nil == uuc.sweepConfig || !common.IsHexAddress(uuc.sweepConfig.CollectionAddress) {
+		return nil, errors.New(500, "SWEEP_ERROR", "归集地址未配置")
+	}
+
+	settings.chainId, err = uuc.withdrawChain.GetChainId(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	tokenList, err = uuc.tokenRepo.GetTokens(ctx)
+	if nil != err {
+		return nil, err
+	}
+	tokens := make(map[string]*Token, 0)
+	for _, v := range tokenList {
+		if 1 == v.Status {
+			tokens[v.Symbol] = v
+		}
+	}
+
+	// 先继续未完成的归集
+	sweeps, err = uuc.sweepRepo.GetSweepsByStatus(ctx, SweepStatusPending, SweepStatusFunding, SweepStatusFunded, SweepStatusSweeping)
+	if nil != err {
+		return nil, err
+	}
+	active := make(map[string]bool, 0)
+	for _, sweep := range sweeps {
+		active[sweep.Address+sweep.Symbol] = true
+		token, ok := tokens[sweep.Symbol]
+		if !ok {
+			continue
+		}
+		if err = uuc.sweepStep(ctx, sweep, token, settings); nil != err {
+			uuc.log.Errorf("sweep %d: %v", sweep.ID, err)
+		}
+	}
+
+	depositAddresses, err = uuc.depositAddressRepo.GetDepositAddresses(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, depositAddress := range depositAddresses {
+		for _, token := range tokens {
+			if active[depositAddress.Address+token.Symbol] {
+				continue
+			}
+
+			balance, err := uuc.withdrawChain.GetTokenBalance(ctx, token.Contract, depositAddress.Address)
+			if nil != err {
+				uuc.log.Errorf("sweep balance %s: %v", depositAddress.Address, err)
+				continue
+			}
+			value, err := token.ToSystemAmount(balance)
+			if nil != err || value < minAmount*10000000000 {
+				continue
+			}
+
+			sweep, err := uuc.sweepRepo.CreateSweep(ctx, &Sweep{
+				Address:   depositAddress.Address,
+				PathIndex: depositAddress.PathIndex,
+				Symbol:    token.Symbol,
+				Amount:    balance.String(),
+				Status:    SweepStatusPending,
+			})
+			if nil != err {
+				uuc.log.Errorf("sweep create %s: %v", depositAddress.Address, err)
+				continue
+			}
+			if err = uuc.sweepStep(ctx, sweep, token, settings); nil != err {
+				uuc.log.Errorf("sweep %d: %v", sweep.ID, err)
+			}
+		}
+	}
+
+	return &v1.AdminSweepReply{}, nil
+}
+
+// sweepStep 按当前状态推进归集，能连续推进的步骤一次完成
+func (uuc *UserUseCase) sweepStep(ctx context.Context, sweep *Sweep, token *Token, settings *sweepSettings) error {
+	var err error
+
+	if SweepStatusPending == sweep.Status {
+		if err = uuc.sweepFund(ctx, sweep); nil != err {
+			return err
+		}
+	}
+
+	if SweepStatusFunding == sweep.Status {
+		if err = uuc.sweepFunding(ctx, sweep, settings); nil != err {
+			return err
+		}
+	}
+
+	if SweepStatusFunded == sweep.Status {
+		if err = uuc.sweepSend(ctx, sweep, token, settings); nil != err {
+			return err
+		}
+	}
+
+	if SweepStatusSweeping == sweep.Status {
+		if err = uuc.sweepTrack(ctx, sweep, token, settings); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sweepFund 充值地址的主币不够付gas时从热钱包补足差额
+func (uuc *UserUseCase) sweepFund(ctx context.Context, sweep *Sweep) error {
+	gasPrice, err := uuc.withdrawChain.SuggestGasPrice(ctx)
+	if nil != err {
+		return err
+	}
+	sweep.GasPrice = gasPrice.String()
+
+	balance, err := uuc.withdrawChain.GetBalance(ctx, sweep.Address)
+	if nil != err {
+		return err
+	}
+
+	need := sweepGasFee(gasPrice)
+	if 0 <= balance.Cmp(need) {
+		return uuc.updateSweep(ctx, sweep, SweepStatusFunded)
+	}
+
+	sweep.FundTxHash, err = uuc.sendWalletTx(ctx, &WalletTx{
+		Kind:      WalletTxKindGas,
+		ToAddress: sweep.Address,
+		Value:     new(big.Int).Sub(need, balance).String(),
+		Data:      "",
+		GasLimit:  21000,
+	})
+	if nil != err {
+		return err
+	}
+
+	return uuc.updateSweep(ctx, sweep, SweepStatusFunding)
+}
+
+// sweepFunding gas到账后继续，超时未到账重新补
+func (uuc *UserUseCase) sweepFunding(ctx context.Context, sweep *Sweep, settings *sweepSettings) error {
+	gasPrice, ok := new(big.Int).SetString(sweep.GasPrice, 10)
+	if !ok {
+		return errors.New(500, "SWEEP_ERROR", "gas价格错误")
+	}
+
+	balance, err := uuc.withdrawChain.GetBalance(ctx, sweep.Address)
+	if nil != err {
+		return err
+	}
+	if 0 <= balance.Cmp(sweepGasFee(gasPrice)) {
+		return uuc.updateSweep(ctx, sweep, SweepStatusFunded)
+	}
+
+	if time.Now().UTC().Sub(sweep.UpdatedAt) < time.Duration(settings.timeoutSeconds)*time.Second {
+		return nil
+	}
+
+	return uuc.retrySweep(ctx, sweep, settings, "补gas超时")
+}
+
+// sweepSend 归集地址当前全部余额，签名后先记录再发送
+func (uuc *UserUseCase) sweepSend(ctx context.Context, sweep *Sweep, token *Token, settings *sweepSettings) error {
+	balance, err := uuc.withdrawChain.GetTokenBalance(ctx, token.Contract, sweep.Address)
+	if nil != err {
+		return err
+	}
+	if 0 == balance.Sign() { // 已被转走
+		sweep.Remark = "余额为0"
+		return uuc.updateSweep(ctx, sweep, SweepStatusDone)
+	}
+
+	sweep.Nonce, err = uuc.withdrawChain.GetNonceAt(ctx, sweep.Address, true)
+	if nil != err {
+		return err
+	}
+	sweep.Amount = balance.String()
+
+	signedTx, err := uuc.signSweepTx(ctx, sweep, token, settings.chainId)
+	if nil != err {
+		return err
+	}
+	sweep.TxHash = signedTx.Hash().Hex()
+
+	if err = uuc.updateSweep(ctx, sweep, SweepStatusSweeping); nil != err {
+		return err
+	}
+
+	return uuc.sendSweepTx(ctx, signedTx)
+}
+
+// sweepTrack nonce上链后按回执完成或重试，超时未上链时重发同一笔交易
+func (uuc *UserUseCase) sweepTrack(ctx context.Context, sweep *Sweep, token *Token, settings *sweepSettings) error {
+	minedNonce, err := uuc.withdrawChain.GetNonceAt(ctx, sweep.Address, false)
+	if nil != err {
+		return err
+	}
+
+	if minedNonce > sweep.Nonce {
+		receipt, err := uuc.withdrawChain.GetTransactionReceipt(ctx, sweep.TxHash)
+		if nil != err {
+			return err
+		}
+		if nil != receipt && 1 == receipt.Status {
+			return uuc.updateSweep(ctx, sweep, SweepStatusDone)
+		}
+		return uuc.retrySweep(ctx, sweep, settings, "归集交易失败")
+	}
+
+	if time.Now().UTC().Sub(sweep.UpdatedAt) < time.Duration(settings.timeoutSeconds)*time.Second {
+		return nil
+	}
+
+	// 同样的内容签名结果不变，重发不会产生新交易
+	signedTx, err := uuc.signSweepTx(ctx, sweep, token, settings.chainId)
+	if nil != err {
+		return err
+	}
+	if signedTx.Hash().Hex() != sweep.TxHash {
+		return errors.New(500, "SWEEP_ERROR", "重签交易hash不一致")
+	}
+
+	return uuc.sendSweepTx(ctx, signedTx)
+}
+
+// retrySweep 回到待补gas，超过重试次数标记失败
+func (uuc *UserUseCase) retrySweep(ctx context.Context, sweep *Sweep, settings *sweepSettings, remark string) error {
+	sweep.Retry += 1
+	sweep.Remark = remark
+	if sweep.Retry > settings.maxRetry {
+		return uuc.updateSweep(ctx, sweep, SweepStatusFailed)
+	}
+
+	return uuc.updateSweep(ctx, sweep, SweepStatusPending)
+}
+
+func (uuc *UserUseCase) updateSweep(ctx context.Context, sweep *Sweep, to string) error {
+	from := sweep.Status
+	sweep.Status = to
+	if err := uuc.sweepRepo.UpdateSweep(ctx, sweep, from); nil != err {
+		sweep.Status = from
+		return err
+	}
+
+	sweep.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// signSweepTx 按记录内容签名，并核对签名地址是充值地址
+func (uuc *UserUseCase) signSweepTx(ctx context.Context, sweep *Sweep, token *Token, chainId *big.Int) (*types.Transaction, error) {
+	gasPrice, ok := new(big.Int).SetString(sweep.GasPrice, 10)
+	if !ok {
+		return nil, errors.New(500, "SWEEP_ERROR", "gas价格错误")
+	}
+	amount, ok := new(big.Int).SetString(sweep.Amount, 10)
+	if !ok {
+		return nil, errors.New(500, "SWEEP_ERROR", "归集数量错误")
+	}
+
+	tx := types.NewTransaction(
+		uint64(sweep.Nonce),
+		common.HexToAddress(token.Contract),
+		big.NewInt(0),
+		sweepGasLimit,
+		gasPrice,
+		erc20TransferData(common.HexToAddress(uuc.sweepConfig.CollectionAddress), amount),
+	)
+	signedTx, err := uuc.sweepSigner.SignSweepTx(ctx, sweep.PathIndex, tx, chainId)
+	if nil != err {
+		return nil, err
+	}
+
+	from, err := types.Sender(types.NewEIP155Signer(chainId), signedTx)
+	if nil != err || !strings.EqualFold(from.Hex(), sweep.Address) {
+		return nil, errors.New(500, "SWEEP_ERROR", "签名地址和充值地址不一致")
+	}
+
+	return signedTx, nil
+}
+
+func (uuc *UserUseCase) sendSweepTx(ctx context.Context, signedTx *types.Transaction) error {
+	err := uuc.withdrawChain.SendTransaction(ctx, signedTx)
+	if nil == err || strings.Contains(err.Error(), "already known") || strings.Contains(err.Error(), "known transaction") {
+		return nil
+	}
+
+	return err
+}
+
+// sweepGasFee 归集交易需要的主币
+func sweepGasFee(gasPrice *big.Int) *big.Int {
+	return new(big.Int).Mul(gasPrice, big.NewInt(sweepGasLimit))
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/amount"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Token 代币，Symbol小写即提现类型
+type Token struct {
+	ID          int64
+	Symbol      string
+	Contract    string
+	Decimals    int64
+	ChainId     int64
+	Status      int64  // 1启用
+	DepositRule string // 充值入账规则，空不接受充值
+	CreatedAt   time.Time
+}
+
+const (
+	TokenDepositLocation = "location"  // 开仓位
+	TokenDepositBalance  = "balance"   // 入余额
+	TokenDepositPair     = "pair"      // 配对中开仓位的一侧，配足pair_coin后开仓位
+	TokenDepositPairCoin = "pair_coin" // 配对中随仓位入dhb余额的一侧
+)
+
+type TokenRepo interface {
+	GetTokens(ctx context.Context) ([]*Token, error)
+	// GetTokenBySymbol 只返回启用的代币
+	GetTokenBySymbol(ctx context.Context, symbol string) (*Token, error)
+	CreateToken(ctx context.Context, t *Token) (*Token, error)
+	UpdateToken(ctx context.Context, t *Token) error
+}
+
+// ToChainAmount 系统金额转为链上精度，打款多出的位数舍去
+func (t *Token) ToChainAmount(v int64) (*big.Int, error) {
+	return amount.ToChain(v, t.Decimals, amount.RoundDown)
+}
+
+// ToSystemAmount 链上精度转为系统金额，入账超出系统精度的部分舍去
+func (t *Token) ToSystemAmount(value *big.Int) (int64, error) {
+	return amount.FromChain(value, t.Decimals, amount.RoundDown)
+}
+
+func (uuc *UserUseCase) AdminTokenList(ctx context.Context, req *v1.AdminTokenListRequest) (*v1.AdminTokenListReply, error) {
+	tokens, err := uuc.tokenRepo.GetTokens(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminTokenListReply{Tokens: make([]*v1.AdminTokenListReply_List, 0)}
+	for _, v := range tokens {
+		res.Tokens = append(res.Tokens, &v1.AdminTokenListReply_List{
+			Id:          v.ID,
+			Symbol:      v.Symbol,
+			Contract:    v.Contract,
+			Decimals:    v.Decimals,
+			ChainId:     v.ChainId,
+			Status:      v.Status,
+			DepositRule: v.DepositRule,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminTokenSave id为0时新增，否则修改
+func (uuc *UserUseCase) AdminTokenSave(ctx context.Context, req *v1.AdminTokenSaveRequest) (*v1.AdminTokenSaveReply, error) {
+	var err error
+
+	res := &v1.AdminTokenSaveReply{}
+
+	if !common.IsHexAddress(req.SendBody.Contract) {
+		return res, errors.New(500, "TOKEN_ERROR", "合约地址错误")
+	}
+	if "" == req.SendBody.Symbol {
+		return res, errors.New(500, "TOKEN_ERROR", "请填写代币符号")
+	}
+	if 0 > req.SendBody.Decimals || amount.MaxDecimals < req.SendBody.Decimals {
+		return res, errors.New(500, "TOKEN_ERROR", "精度错误")
+	}
+
+	symbol := strings.ToUpper(req.SendBody.Symbol)
+	switch req.SendBody.DepositRule {
+	case "", TokenDepositLocation:
+	case TokenDepositBalance:
+		if "USDT" != symbol && "DHB" != symbol { // 只有这两种余额
+			return res, errors.New(500, "TOKEN_ERROR", "该代币没有对应余额")
+		}
+	case TokenDepositPair:
+	case TokenDepositPairCoin:
+		if "DHB" != symbol { // 随仓位入dhb余额
+			return res, errors.New(500, "TOKEN_ERROR", "配对的币只支持dhb")
+		}
+	default:
+		return res, errors.New(500, "TOKEN_ERROR", "充值规则错误")
+	}
+
+	t := &Token{
+		ID:          req.SendBody.Id,
+		Symbol:      symbol,
+		Contract:    strings.ToLower(req.SendBody.Contract),
+		Decimals:    req.SendBody.Decimals,
+		ChainId:     req.SendBody.ChainId,
+		Status:      req.SendBody.Status,
+		DepositRule: req.SendBody.DepositRule,
+	}
+	if 0 == t.ID {
+		_, err = uuc.tokenRepo.CreateToken(ctx, t)
+	} else {
+		err = uuc.tokenRepo.UpdateToken(ctx, t)
+	}
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
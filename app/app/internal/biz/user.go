@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/md5"
 	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/amount"
 	"dhb/app/app/internal/pkg/middleware/auth"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
@@ -99,8 +100,10 @@ type Withdraw struct {
 	Amount          int64
 	RelAmount       int64
 	BalanceRecordId int64
-	Status          string
+	Status          WithdrawStatus
 	Type            string
+	Reason          string
+	TxHash          string
 	CreatedAt       time.Time
 }
 
@@ -112,6 +115,17 @@ type UserUseCase struct {
 	ubRepo                        UserBalanceRepo
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	nonceRepo                     NonceRepo
+	withdrawChain                 WithdrawChain
+	walletTxRepo                  WalletTxRepo
+	tokenRepo                     TokenRepo
+	depositAddressRepo            DepositAddressRepo
+	addressDeriver                AddressDeriver
+	sweepRepo                     SweepRepo
+	sweepSigner                   SweepSigner
+	sweepConfig                   *SweepConfig
+	signer                        Signer
+	productRepo                   ProductRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -129,11 +143,30 @@ type Reward struct {
 	CreatedAt        time.Time
 }
 
+type UserBalanceRecord struct {
+	ID        int64
+	UserId    int64
+	Balance   int64
+	Amount    int64
+	Type      string
+	CoinType  string
+	CreatedAt time.Time
+}
+
 type Pagination struct {
 	PageNum  int
 	PageSize int
 }
 
+// RewardFilter 收益记录筛选条件，时间为左闭右开
+type RewardFilter struct {
+	UserId    int64
+	Reasons   []string
+	Type      string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
 type UserArea struct {
 	ID         int64
 	UserId     int64
@@ -174,23 +207,39 @@ type UserBalanceRepo interface {
 	NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error)
 	NormalWithdrawRecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, reasonId int64, status string) (int64, error)
 	Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error)
+	TopUp(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error)
+	DepositBalance(ctx context.Context, userId int64, amount int64, coinType string) (int64, error)
+	GetUserBalanceRecordById(ctx context.Context, id int64) (*UserBalanceRecord, error)
+	// GetRewardsByLocationNewId 由该仓位产生的收益，包括入单和追加的直推奖励、每日收益和团队奖励
+	GetRewardsByLocationNewId(ctx context.Context, locationId int64) ([]*Reward, error)
 	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
 	GetUserRewards(ctx context.Context, b *Pagination, userId int64, reason string) ([]*Reward, error, int64)
+	GetUserRewardsByCursor(ctx context.Context, f *RewardFilter, cursor int64, limit int) ([]*Reward, error)
+	GetUserRewardsTotal(ctx context.Context, f *RewardFilter) (int64, int64, error)
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalance, error)
 	GetUserBalanceUsdtTotal(ctx context.Context) (int64, error)
 	GetUserBalanceDHBTotal(ctx context.Context) (int64, error)
-	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*Withdraw, error)
-	WithdrawUsdt(ctx context.Context, userId int64, amount int64) error
-	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
+	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, balanceRecordId int64) (*Withdraw, error)
+	WithdrawUsdt(ctx context.Context, userId int64, amount int64) (int64, error)
+	WithdrawDhb(ctx context.Context, userId int64, amount int64) (int64, error)
+	GetUserWithdrawTotalTodayByUserId(ctx context.Context, userId int64, coinType string) (int64, error)
 	GetWithdrawByUserId(ctx context.Context, userId int64) ([]*Withdraw, error)
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64, withdrawType string) ([]*Withdraw, error, int64)
 	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
-	GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error)
-	UpdateWithdraw(ctx context.Context, id int64, status string) (*Withdraw, error)
+	GetWithdrawPassOrRewardedFirst(ctx context.Context, excludeIds ...int64) (*Withdraw, error)
+	UpdateWithdrawStatus(ctx context.Context, id int64, from WithdrawStatus, to WithdrawStatus) error
+	CreateWithdrawEvent(ctx context.Context, e *WithdrawEvent) error
+	GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*WithdrawEvent, error)
+	UpdateWithdrawReason(ctx context.Context, id int64, reason string) error
+	UpdateWithdrawTxHash(ctx context.Context, id int64, txHash string) error
+	GetWithdrawsByStatus(ctx context.Context, status WithdrawStatus) ([]*Withdraw, error)
+	RefundWithdraw(ctx context.Context, withdrawId int64, userId int64, amount int64, coinType string) (int64, error)
+	GetSystemFeeByWithdrawId(ctx context.Context, withdrawId int64) (int64, error)
+	SystemFeeRefund(ctx context.Context, amount int64, withdrawId int64) error
 	GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error)
 	GetWithdrawNotDeal(ctx context.Context) ([]*Withdraw, error)
 	GetWithdrawByUserIds(ctx context.Context, userIds []int64) ([]*Withdraw, error)
@@ -205,11 +254,10 @@ type UserBalanceRepo interface {
 	GetUserRewardBalanceRewardTotal(ctx context.Context) (int64, error)
 	GetBalanceRewardTotal(ctx context.Context) (int64, error)
 	GetSystemRewardUsdtTotal(ctx context.Context) (int64, error)
-	UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*Withdraw, error)
+	UpdateWithdrawAmount(ctx context.Context, id int64, from WithdrawStatus, to WithdrawStatus, amount int64) error
 	GetUserRewardRecommendSort(ctx context.Context) ([]*UserSortRecommendReward, error)
 	UpdateBalance(ctx context.Context, userId int64, amount int64) (bool, error)
 
-	UpdateWithdrawPass(ctx context.Context, id int64) (*Withdraw, error)
 	UserDailyBalanceReward(ctx context.Context, userId int64, rewardAmount int64, amount int64, amountDhb int64, status string) (int64, error)
 	GetBalanceRewardCurrent(ctx context.Context, now time.Time) ([]*BalanceReward, error)
 	UserDailyLocationReward(ctx context.Context, userId int64, rewardAmount int64, amount int64, coinAmount int64, status string, locationId int64) (int64, error)
@@ -273,7 +321,7 @@ type UserRepo interface {
 	UpdateAdminPassword(ctx context.Context, account string, password string) (*Admin, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, nonceRepo NonceRepo, withdrawChain WithdrawChain, walletTxRepo WalletTxRepo, tokenRepo TokenRepo, depositAddressRepo DepositAddressRepo, addressDeriver AddressDeriver, sweepRepo SweepRepo, sweepSigner SweepSigner, sweepConfig *SweepConfig, signer Signer, productRepo ProductRepo, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
@@ -283,6 +331,17 @@ func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		nonceRepo:                     nonceRepo,
+		withdrawChain:                 withdrawChain,
+		walletTxRepo:                  walletTxRepo,
+		tokenRepo:                     tokenRepo,
+		depositAddressRepo:            depositAddressRepo,
+		addressDeriver:                addressDeriver,
+		sweepRepo:                     sweepRepo,
+		sweepSigner:                   sweepSigner,
+		sweepConfig:                   sweepConfig,
+		signer:                        signer,
+		productRepo:                   productRepo,
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -297,13 +356,365 @@ func (uuc *UserUseCase) GetDhbConfig(ctx context.Context) ([]*Config, error) {
 
 func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *User, req *v1.EthAuthorizeRequest) (*User, error) {
 	var (
-		user *User
+		user          *User
+		recommendUser *User
+		userRecommend *UserRecommend
+		err           error
 	)
+
+	u.Address = strings.ToLower(u.Address)                // 链上记录的地址都是小写
+	user, err = uuc.repo.GetUserByAddress(ctx, u.Address) // 查询用户
+	if nil != user {
+		return user, nil
+	}
+	if nil != err && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 推荐码为推荐人地址或D加推荐人id，为空时为顶级用户
+	code := strings.TrimSpace(req.SendBody.Code)
+	if "" != code {
+		if strings.HasPrefix(code, "0x") {
+			if strings.EqualFold(code, u.Address) {
+				return nil, errors.New(500, "USER_ERROR", "不能使用自己的推荐码")
+			}
+			recommendUser, err = uuc.repo.GetUserByAddress(ctx, strings.ToLower(code))
+		} else {
+			var recommendUserId int64
+			if recommendUserId, err = strconv.ParseInt(strings.TrimPrefix(code, "D"), 10, 64); nil != err || 0 >= recommendUserId {
+				return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
+			}
+			recommendUser, err = uuc.repo.GetUserById(ctx, recommendUserId)
+		}
+		if nil == recommendUser || nil != err {
+			return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
+		}
+
+		// 查询推荐人的推荐关系
+		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, recommendUser.ID)
+		if nil == userRecommend || nil != err {
+			return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		user, err = uuc.repo.CreateUser(ctx, u) // 用户创建
+		if err != nil {
+			return err
+		}
+
+		_, err = uuc.uiRepo.CreateUserInfo(ctx, user) // 创建用户信息
+		if err != nil {
+			return err
+		}
+
+		_, err = uuc.urRepo.CreateUserRecommend(ctx, user, userRecommend) // 创建用户推荐关系，推荐人的推荐链加上推荐人
+		if err != nil {
+			return err
+		}
+
+		_, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
+		if err != nil {
+			return err
+		}
+
+		_, err = uuc.urRepo.CreateUserArea(ctx, user) // 创建区信息
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// 分配充值地址，失败时查看用户信息时再补
+	if _, err = uuc.GetDepositAddress(ctx, user.ID); nil != err {
+		uuc.log.Error(err)
+	}
+
 	return user, nil
 }
 
+// GetDepositAddress 获取用户充值地址，没有时按用户id派生并保存
+func (uuc *UserUseCase) GetDepositAddress(ctx context.Context, userId int64) (*DepositAddress, error) {
+	depositAddress, err := uuc.depositAddressRepo.GetDepositAddressByUserId(ctx, userId)
+	if nil == err {
+		return depositAddress, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	address, err := uuc.addressDeriver.DeriveAddress(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	depositAddress, err = uuc.depositAddressRepo.CreateDepositAddress(ctx, &DepositAddress{
+		UserId:    userId,
+		Address:   strings.ToLower(address),
+		PathIndex: userId,
+	})
+	if nil != err { // 并发时已被创建
+		return uuc.depositAddressRepo.GetDepositAddressByUserId(ctx, userId)
+	}
+
+	return depositAddress, nil
+}
+
 func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoReply, error) {
-	return &v1.UserInfoReply{}, nil
+	var (
+		myUser                     *User
+		userInfo                   *UserInfo
+		userArea                   *UserArea
+		userBalance                *UserBalance
+		userRecommend              *UserRecommend
+		myRecommendUsers           []*UserRecommend
+		myTeamUsers                []*UserRecommend
+		locations                  []*LocationNew
+		teamLocations              []*LocationNew
+		userRewards                []*Reward
+		userCurrentMonthRecommends []*UserCurrentMonthRecommend
+		configs                    []*Config
+		inviteUserAddress          string
+		status                     string
+		amount                     int64
+		amountB                    int64
+		recommendNum               int64
+		recommendTeamNum           int64
+		total                      int64
+		feeTotal                   int64
+		recommendTotal             int64
+		locationTotal              int64
+		userCount                  int64
+		level1Dhb                  string
+		level2Dhb                  string
+		level3Dhb                  string
+		err                        error
+	)
+
+	myUser, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userInfo, err = uuc.uiRepo.GetUserInfoByUserId(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userArea, err = uuc.urRepo.GetUserArea(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	// 推荐人，推荐链最后一位
+	if "" != userRecommend.RecommendCode {
+		tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
+		if 2 <= len(tmpRecommendUserIds) {
+			myUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
+			if 0 < myUserRecommendUserId {
+				if inviteUser, err := uuc.repo.GetUserById(ctx, myUserRecommendUserId); nil == err {
+					inviteUserAddress = inviteUser.Address
+				}
+			}
+		}
+	}
+
+	// 直推和团队，有进行中的位置才算有效
+	myCode := userRecommend.RecommendCode + "D" + strconv.FormatInt(myUser.ID, 10)
+	myRecommendUsers, _ = uuc.urRepo.GetUserRecommendByCode(ctx, myCode)
+	myTeamUsers, _ = uuc.urRepo.GetUserRecommendLikeCode(ctx, myCode)
+	if 0 < len(myTeamUsers) {
+		var teamUserIds []int64
+		for _, vMyTeamUsers := range myTeamUsers {
+			teamUserIds = append(teamUserIds, vMyTeamUsers.UserId)
+		}
+
+		teamLocations, _ = uuc.locationRepo.GetLocationByIds(ctx, teamUserIds...)
+		runningUsers := make(map[int64]bool, 0)
+		for _, vTeamLocations := range teamLocations {
+			if "running" == vTeamLocations.Status {
+				runningUsers[vTeamLocations.UserId] = true
+			}
+		}
+
+		for _, vMyRecommendUsers := range myRecommendUsers {
+			if runningUsers[vMyRecommendUsers.UserId] {
+				recommendNum++
+			}
+		}
+		recommendTeamNum = int64(len(runningUsers))
+	}
+
+	// 当前位置，取最新的一个
+	locations, _ = uuc.locationRepo.GetLocationsNewByUserId(ctx, myUser.ID)
+	if 0 < len(locations) {
+		status = locations[0].Status
+		amount = locations[0].Current
+		amountB = locations[0].CurrentMax
+	}
+
+	// 收益统计
+	userRewards, _ = uuc.ubRepo.GetUserRewardByUserId(ctx, myUser.ID)
+	for _, vUserRewards := range userRewards {
+		total += vUserRewards.Amount
+		if "fee" == vUserRewards.Reason || "fee_daily" == vUserRewards.Reason {
+			feeTotal += vUserRewards.Amount
+		} else if strings.HasPrefix(vUserRewards.Reason, "recommend") || "daily_recommend_area" == vUserRewards.Reason {
+			recommendTotal += vUserRewards.Amount
+		} else if "location" == vUserRewards.Reason || "location_daily_reward" == vUserRewards.Reason {
+			locationTotal += vUserRewards.Amount
+		}
+	}
+
+	userCurrentMonthRecommends, _ = uuc.userCurrentMonthRecommendRepo.GetUserCurrentMonthRecommendByUserId(ctx, myUser.ID)
+	userCount, _ = uuc.repo.GetUserCount(ctx)
+
+	// 前端展示余额和充值用的代币
+	tokens := make([]*v1.UserInfoReply_Token, 0)
+	if tokenList, err := uuc.tokenRepo.GetTokens(ctx); nil == err {
+		for _, v := range tokenList {
+			if 1 != v.Status {
+				continue
+			}
+			tokens = append(tokens, &v1.UserInfoReply_Token{
+				Symbol:   v.Symbol,
+				Contract: v.Contract,
+				Decimals: v.Decimals,
+				ChainId:  v.ChainId,
+			})
+		}
+	}
+
+	var depositAddress string
+	if d, err := uuc.GetDepositAddress(ctx, myUser.ID); nil == err {
+		depositAddress = d.Address
+	}
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "level1Dhb", "level2Dhb", "level3Dhb")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "level1Dhb" == vConfig.KeyName {
+				level1Dhb = vConfig.Value
+			} else if "level2Dhb" == vConfig.KeyName {
+				level2Dhb = vConfig.Value
+			} else if "level3Dhb" == vConfig.KeyName {
+				level3Dhb = vConfig.Value
+			}
+		}
+	}
+
+	return &v1.UserInfoReply{
+		Address:                  myUser.Address,
+		Level:                    userArea.Level,
+		Status:                   status,
+		Amount:                   fmt.Sprintf("%.2f", float64(amount)/float64(10000000000)),
+		AmountB:                  fmt.Sprintf("%.2f", float64(amountB)/float64(10000000000)),
+		BalanceUsdt:              fmt.Sprintf("%.2f", float64(userBalance.BalanceUsdt)/float64(10000000000)),
+		BalanceDhb:               fmt.Sprintf("%.2f", float64(userBalance.BalanceDhb)/float64(10000000000)),
+		InviteUrl:                myUser.Address,
+		InviteUserAddress:        inviteUserAddress,
+		RecommendNum:             recommendNum,
+		RecommendTeamNum:         recommendTeamNum,
+		RecommendNumAll:          userInfo.HistoryRecommend, // 历史有效直推
+		RecommendTeamAll:         int64(len(myTeamUsers)),
+		Total:                    fmt.Sprintf("%.2f", float64(total)/float64(10000000000)),
+		FeeTotal:                 fmt.Sprintf("%.2f", float64(feeTotal)/float64(10000000000)),
+		RecommendTotal:           fmt.Sprintf("%.2f", float64(recommendTotal)/float64(10000000000)),
+		LocationTotal:            fmt.Sprintf("%.2f", float64(locationTotal)/float64(10000000000)),
+		CurrentMonthRecommendNum: int64(len(userCurrentMonthRecommends)),
+		Level1Dhb:                level1Dhb,
+		Level2Dhb:                level2Dhb,
+		Level3Dhb:                level3Dhb,
+		UserCount:                strconv.FormatInt(userCount, 10),
+		Tokens:                   tokens,
+		DepositAddress:           depositAddress,
+	}, nil
+}
+
+var (
+	// 各列表可查的收益原因
+	locationRewardReasons  = []string{"location", "location_daily_reward", "daily_balance_reward", "last_reward"}
+	recommendRewardReasons = []string{"recommend", "recommend_team", "recommend_top", "recommend_vip", "recommend_vip_top", "daily_recommend_area"}
+	feeRewardReasons       = []string{"fee", "fee_daily"}
+)
+
+// userRewards 按游标分页查询用户收益，返回记录、下一页游标、总条数和总金额
+func (uuc *UserUseCase) userRewards(ctx context.Context, userId int64, reasons []string, rewardType string, reason string, startDate string, endDate string, cursor int64, pageSize int64) ([]*Reward, int64, int64, int64, error) {
+	var (
+		rewards []*Reward
+		count   int64
+		total   int64
+		err     error
+	)
+
+	f := &RewardFilter{
+		UserId:  userId,
+		Reasons: reasons,
+		Type:    rewardType,
+	}
+
+	if "" != reason {
+		f.Reasons = nil
+		for _, v := range reasons {
+			if reason == v {
+				f.Reasons = []string{reason}
+			}
+		}
+		if nil == f.Reasons {
+			return nil, 0, 0, 0, errors.New(500, "REWARD_ERROR", "无效的收益类型")
+		}
+	}
+
+	// 日期按东八区，数据库存的utc
+	if "" != startDate {
+		f.StartTime, err = time.Parse("2006-01-02", startDate)
+		if nil != err {
+			return nil, 0, 0, 0, errors.New(500, "REWARD_ERROR", "开始日期格式错误")
+		}
+		f.StartTime = f.StartTime.Add(-8 * time.Hour)
+	}
+	if "" != endDate {
+		f.EndTime, err = time.Parse("2006-01-02", endDate)
+		if nil != err {
+			return nil, 0, 0, 0, errors.New(500, "REWARD_ERROR", "结束日期格式错误")
+		}
+		f.EndTime = f.EndTime.Add(16 * time.Hour) // 包含结束日期当天
+	}
+
+	if 0 >= pageSize || 100 < pageSize {
+		pageSize = 20
+	}
+
+	rewards, err = uuc.ubRepo.GetUserRewardsByCursor(ctx, f, cursor, int(pageSize))
+	if nil != err {
+		return nil, 0, 0, 0, err
+	}
+
+	total, count, err = uuc.ubRepo.GetUserRewardsTotal(ctx, f)
+	if nil != err {
+		return nil, 0, 0, 0, err
+	}
+
+	var nextCursor int64
+	if int(pageSize) == len(rewards) {
+		nextCursor = rewards[len(rewards)-1].ID
+	}
+
+	return rewards, nextCursor, count, total, nil
 }
 
 func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
@@ -311,22 +722,77 @@ func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListReques
 		Rewards: make([]*v1.RewardListReply_List, 0),
 	}
 
+	rewards, nextCursor, count, total, err := uuc.userRewards(ctx, user.ID, locationRewardReasons, req.Type, req.Reason, req.StartDate, req.EndDate, req.Cursor, req.PageSize)
+	if nil != err {
+		return res, err
+	}
+
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.RewardListReply_List{
+			Id:             vReward.ID,
+			CreatedAt:      vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:         fmt.Sprintf("%.2f", float64(vReward.Amount)/float64(10000000000)),
+			LocationStatus: vReward.LocationType,
+			Type:           vReward.Type,
+			Reason:         vReward.Reason,
+		})
+	}
+	res.NextCursor = nextCursor
+	res.Count = count
+	res.Total = fmt.Sprintf("%.2f", float64(total)/float64(10000000000))
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
+func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest, user *User) (*v1.RecommendRewardListReply, error) {
 	res := &v1.RecommendRewardListReply{
 		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
 	}
 
+	rewards, nextCursor, count, total, err := uuc.userRewards(ctx, user.ID, recommendRewardReasons, req.Type, req.Reason, req.StartDate, req.EndDate, req.Cursor, req.PageSize)
+	if nil != err {
+		return res, err
+	}
+
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+			Id:        vReward.ID,
+			CreatedAt: vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(vReward.Amount)/float64(10000000000)),
+			Type:      vReward.Type,
+			Reason:    vReward.Reason,
+		})
+	}
+	res.NextCursor = nextCursor
+	res.Count = count
+	res.Total = fmt.Sprintf("%.2f", float64(total)/float64(10000000000))
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
+func (uuc *UserUseCase) FeeRewardList(ctx context.Context, req *v1.FeeRewardListRequest, user *User) (*v1.FeeRewardListReply, error) {
 	res := &v1.FeeRewardListReply{
 		Rewards: make([]*v1.FeeRewardListReply_List, 0),
 	}
 
+	rewards, nextCursor, count, total, err := uuc.userRewards(ctx, user.ID, feeRewardReasons, req.Type, req.Reason, req.StartDate, req.EndDate, req.Cursor, req.PageSize)
+	if nil != err {
+		return res, err
+	}
+
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+			Id:        vReward.ID,
+			CreatedAt: vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(vReward.Amount)/float64(10000000000)),
+			Type:      vReward.Type,
+			Reason:    vReward.Reason,
+		})
+	}
+	res.NextCursor = nextCursor
+	res.Count = count
+	res.Total = fmt.Sprintf("%.2f", float64(total)/float64(10000000000))
+
 	return res, nil
 }
 
@@ -338,7 +804,115 @@ func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.Withd
 	return res, nil
 }
 
+// parseAmount 十进制金额转为系统精度，不允许超出系统精度
+func parseAmount(s string) (int64, error) {
+	res, err := amount.Parse(s, amount.RoundExact)
+	if nil != err {
+		switch err {
+		case amount.ErrPrecision:
+			return 0, errors.New(500, "AMOUNT_ERROR", "金额精度超出")
+		case amount.ErrOverflow:
+			return 0, errors.New(500, "AMOUNT_ERROR", "金额超出范围")
+		default:
+			return 0, errors.New(500, "AMOUNT_ERROR", "金额格式错误")
+		}
+	}
+
+	return res, nil
+}
+
 func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, user *User) (*v1.WithdrawReply, error) {
+	var (
+		amount        int64
+		configs       []*Config
+		withdrawMin   int64
+		withdrawMax   int64
+		withdrawDaily int64
+		globalLock    *GlobalLock
+		err           error
+	)
+
+	if nil == req.SendBody {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "参数错误")
+	}
+	coinType := req.SendBody.Type
+	if "usdt" != coinType && "dhb" != coinType {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "币种错误")
+	}
+
+	amount, err = parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= amount {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现金额错误")
+	}
+
+	// 系统锁定时不可提现
+	globalLock, err = uuc.locationRepo.GetLockGlobalLocation(ctx)
+	if nil != err || 1 != globalLock.Status {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "系统维护中，暂停提现")
+	}
+
+	// 配置，整数币数，0为不限制
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "withdraw_"+coinType+"_min", "withdraw_"+coinType+"_max", "withdraw_"+coinType+"_daily")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_"+coinType+"_min" == vConfig.KeyName {
+				withdrawMin, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "withdraw_"+coinType+"_max" == vConfig.KeyName {
+				withdrawMax, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "withdraw_"+coinType+"_daily" == vConfig.KeyName {
+				withdrawDaily, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	if 0 < withdrawMin && amount < withdrawMin*10000000000 {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "低于最小提现金额")
+	}
+	if 0 < withdrawMax && amount > withdrawMax*10000000000 {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "超过最大提现金额")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var balanceRecordId int64
+
+		// 先扣余额，余额行在事务内被锁住，同一用户的提现串行执行
+		if "dhb" == coinType {
+			balanceRecordId, err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount)
+		} else {
+			balanceRecordId, err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount)
+		}
+		if nil != err {
+			if errors.IsNotFound(err) {
+				return errors.New(500, "WITHDRAW_ERROR", "余额不足")
+			}
+			return err
+		}
+
+		_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, coinType, balanceRecordId)
+		if nil != err {
+			return err
+		}
+
+		// 当日累计含本次
+		if 0 < withdrawDaily {
+			var todayTotal int64
+			todayTotal, err = uuc.ubRepo.GetUserWithdrawTotalTodayByUserId(ctx, user.ID, coinType)
+			if nil != err {
+				return err
+			}
+			if todayTotal > withdrawDaily*10000000000 {
+				return errors.New(500, "WITHDRAW_ERROR", "超过当日提现额度")
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
 	return &v1.WithdrawReply{
 		Status: "ok",
 	}, nil
@@ -942,7 +1516,7 @@ func (uuc *UserUseCase) AdminWithdrawPass(ctx context.Context, req *v1.AdminWith
 
 	res := &v1.AdminWithdrawPassReply{}
 
-	_, err = uuc.ubRepo.UpdateWithdrawPass(ctx, req.SendBody.Id)
+	err = uuc.updateWithdrawStatus(ctx, req.SendBody.Id, WithdrawStatusPass, "")
 	if nil != err {
 		return res, err
 	}
@@ -994,11 +1568,12 @@ func (uuc *UserUseCase) AdminBalanceUpdate(ctx context.Context, req *v1.AdminBal
 	)
 	res := &v1.AdminBalanceUpdateReply{}
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	balance, err := parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return res, err
+	}
 
-	_, err = uuc.ubRepo.UpdateBalance(ctx, req.SendBody.UserId, amount) // 推荐人信息修改
+	_, err = uuc.ubRepo.UpdateBalance(ctx, req.SendBody.UserId, balance) // 推荐人信息修改
 	if nil != err {
 		return res, err
 	}
@@ -1022,7 +1597,7 @@ func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *v1.AdminLoginReques
 
 	claims := auth.CustomClaims{
 		UserId:   admin.ID,
-		UserType: "admin",
+		UserType: auth.UserTypeAdmin,
 		StandardClaims: jwt2.StandardClaims{
 			NotBefore: time.Now().Unix(),              // 签名的生效时间
 			ExpiresAt: time.Now().Unix() + 60*60*24*7, // 7天过期
@@ -1360,16 +1935,8 @@ func (uuc *UserUseCase) AuthAdminDelete(ctx context.Context, req *v1.AuthAdminDe
 	return res, err
 }
 
-func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
-	return uuc.ubRepo.GetWithdrawPassOrRewardedFirst(ctx)
-}
-
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
-}
-
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
+func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context, excludeIds ...int64) (*Withdraw, error) {
+	return uuc.ubRepo.GetWithdrawPassOrRewardedFirst(ctx, excludeIds...)
 }
 
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
@@ -1427,8 +1994,10 @@ func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWith
 			Id:        v.ID,
 			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
-			Status:    v.Status,
+			Status:    string(v.Status),
 			Type:      v.Type,
+			Reason:    v.Reason,
+			TxHash:    v.TxHash,
 			Address:   users[v.UserId].Address,
 			RelAmount: fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(10000000000)),
 		})
@@ -1525,7 +2094,7 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 	}
 
 	for _, withdraw := range withdrawNotDeal {
-		if "" != withdraw.Status {
+		if WithdrawStatusApply != withdraw.Status {
 			continue
 		}
 
@@ -1533,7 +2102,8 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 
 		if "dhb" == withdraw.Type { // 提现dhb
 			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				_, err = uuc.ubRepo.UpdateWithdrawAmount(ctx, withdraw.ID, "rewarded", currentValue)
+				withdraw.RelAmount = currentValue
+				err = uuc.transitWithdraw(ctx, withdraw, WithdrawStatusRewarded, "")
 				if nil != err {
 					return err
 				}
@@ -1555,7 +2125,8 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 				return err
 			}
 
-			_, err = uuc.ubRepo.UpdateWithdrawAmount(ctx, withdraw.ID, "rewarded", currentValue)
+			withdraw.RelAmount = currentValue
+			err = uuc.transitWithdraw(ctx, withdraw, WithdrawStatusRewarded, "")
 			if nil != err {
 				return err
 			}
@@ -1673,6 +2244,7 @@ func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.Ad
 
 	var (
 		userLocations             []*LocationNew
+		products                  []*Product
 		configs                   []*Config
 		locationRewardRate        int64
 		rewardRate                int64
@@ -1770,6 +2342,16 @@ func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.Ad
 		}
 	}
 
+	// 来自套餐的仓位按套餐的分红比例
+	products, err = uuc.productRepo.GetProducts(ctx)
+	if nil != err {
+		return &v1.AdminDailyLocationRewardReply{}, err
+	}
+	productsMap := make(map[int64]*Product, 0)
+	for _, vProducts := range products {
+		productsMap[vProducts.ID] = vProducts
+	}
+
 	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
 	if nil != err {
 		return &v1.AdminDailyLocationRewardReply{}, nil
@@ -1781,7 +2363,16 @@ func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.Ad
 			tmpRecommendUserIds []string
 		)
 
-		tmpCurrentReward := vUserLocations.CurrentMax * 100 / vUserLocations.OutRate * locationRewardRate / 100
+		tmpLocationRewardRate := locationRewardRate
+		tmpRewardRate := rewardRate
+		tmpCoinRewardRate := coinRewardRate
+		if product, ok := productsMap[vUserLocations.ProductId]; ok {
+			tmpLocationRewardRate = product.LocationRewardRate
+			tmpRewardRate = product.RewardRate
+			tmpCoinRewardRate = product.CoinRewardRate
+		}
+
+		tmpCurrentReward := vUserLocations.CurrentMax * 100 / vUserLocations.OutRate * tmpLocationRewardRate / 100
 
 		// 推荐人
 		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, vUserLocations.UserId)
@@ -1893,8 +2484,8 @@ func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.Ad
 		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 			tmpCurrentStatus := vUserLocations.Status // 现在还在运行中
 
-			tmpUsdtAmount := tmpCurrentReward * rewardRate / 100 // 记录下一次
-			tmpBalanceCoinAmount := tmpCurrentReward * coinRewardRate / 100 * 1000 / coinPrice
+			tmpUsdtAmount := tmpCurrentReward * tmpRewardRate / 100 // 记录下一次
+			tmpBalanceCoinAmount := tmpCurrentReward * tmpCoinRewardRate / 100 * 1000 / coinPrice
 
 			vUserLocations.Status = "running"
 			vUserLocations.Current += tmpCurrentReward
@@ -1903,8 +2494,8 @@ func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.Ad
 					vUserLocations.StopDate = time.Now().UTC().Add(8 * time.Hour)
 
 					lastRewardAmount := tmpCurrentReward - (vUserLocations.Current - vUserLocations.CurrentMax)
-					tmpUsdtAmount = lastRewardAmount * rewardRate / 100 // 记录下一次
-					tmpBalanceCoinAmount = lastRewardAmount * coinRewardRate / 100 * 1000 / coinPrice
+					tmpUsdtAmount = lastRewardAmount * tmpRewardRate / 100 // 记录下一次
+					tmpBalanceCoinAmount = lastRewardAmount * tmpCoinRewardRate / 100 * 1000 / coinPrice
 				}
 				vUserLocations.Status = "stop"
 			}
@@ -0,0 +1,405 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WalletTxKindTransfer = "transfer" // 打款
+	WalletTxKindCancel   = "cancel"   // 同nonce转给自己0，取消原交易
+	WalletTxKindGas      = "gas"      // 给充值地址补归集用的gas
+
+	WalletTxStatusPending  = "pending"  // 已发送未上链
+	WalletTxStatusReplaced = "replaced" // 已被同nonce交易替换
+	WalletTxStatusReleased = "released" // 发送失败，nonce已回收
+	WalletTxStatusGap      = "gap"      // 发送失败且nonce无法回收，需发送取消交易补上
+	WalletTxStatusMined    = "mined"    // nonce已上链
+)
+
+// withdrawGasLimit 打款交易的gas上限
+const withdrawGasLimit = 30000000
+
+// WalletTx 热钱包按nonce发出的交易，替换交易是同nonce的新记录
+type WalletTx struct {
+	ID         int64
+	Address    string
+	Nonce      int64
+	WithdrawId int64
+	Kind       string
+	TxHash     string
+	ToAddress  string
+	Value      string
+	Data       string
+	GasLimit   int64
+	GasPrice   string
+	Status     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WalletTxRepo interface {
+	// AllocateNonce 锁定钱包nonce并分配下一个，需在事务中调用，chainNonce为链上pending nonce
+	AllocateNonce(ctx context.Context, address string, chainNonce int64) (int64, error)
+	// ReleaseNonce 只有nonce是最后分配的一个时才能回收
+	ReleaseNonce(ctx context.Context, address string, nonce int64) (bool, error)
+	CreateWalletTx(ctx context.Context, w *WalletTx) (*WalletTx, error)
+	UpdateWalletTxStatus(ctx context.Context, id int64, from string, to string) error
+	GetWalletTxById(ctx context.Context, id int64) (*WalletTx, error)
+	GetWalletTxsByStatus(ctx context.Context, address string, status ...string) ([]*WalletTx, error)
+	GetWalletTxsByWithdrawId(ctx context.Context, withdrawId int64) ([]*WalletTx, error)
+}
+
+// erc20TransferData transfer(address,uint256) 调用数据
+func erc20TransferData(to common.Address, amount *big.Int) []byte {
+	var data []byte
+	data = append(data, transferMethodId...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return data
+}
+
+// WithdrawTransfer 热钱包转出提现代币，失败重试时复用同一个nonce，不会重复打款
+func (uuc *UserUseCase) WithdrawTransfer(ctx context.Context, withdraw *Withdraw, toAddress string) (string, error) {
+	token, err := uuc.tokenRepo.GetTokenBySymbol(ctx, withdraw.Type)
+	if nil != err {
+		return "", err
+	}
+
+	value, err := token.ToChainAmount(withdraw.RelAmount)
+	if nil != err {
+		return "", errors.New(500, "AMOUNT_ERROR", err.Error())
+	}
+
+	return uuc.sendWalletTx(ctx, &WalletTx{
+		WithdrawId: withdraw.ID,
+		Kind:       WalletTxKindTransfer,
+		ToAddress:  token.Contract,
+		Value:      "0",
+		Data:       hexutil.Encode(erc20TransferData(common.HexToAddress(toAddress), value)),
+		GasLimit:   withdrawGasLimit,
+	})
+}
+
+// sendWalletTx 分配nonce、签名并记录后发送，发送失败时回收nonce
+func (uuc *UserUseCase) sendWalletTx(ctx context.Context, w *WalletTx) (string, error) {
+	var (
+		chainId  *big.Int
+		gasPrice *big.Int
+		signedTx *types.Transaction
+		walletTx *WalletTx
+		err      error
+	)
+
+	w.Address = strings.ToLower(uuc.signer.Address().Hex())
+
+	chainId, err = uuc.withdrawChain.GetChainId(ctx)
+	if nil != err {
+		return "", err
+	}
+	gasPrice, err = uuc.withdrawChain.SuggestGasPrice(ctx)
+	if nil != err {
+		return "", err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var chainNonce int64
+		chainNonce, err = uuc.withdrawChain.GetNonceAt(ctx, w.Address, true)
+		if nil != err {
+			return err
+		}
+
+		w.Nonce, err = uuc.walletTxRepo.AllocateNonce(ctx, w.Address, chainNonce)
+		if nil != err {
+			return err
+		}
+
+		w.GasPrice = gasPrice.String()
+		signedTx, err = uuc.signWalletTx(ctx, w, chainId)
+		if nil != err {
+			return err
+		}
+
+		w.TxHash = signedTx.Hash().Hex()
+		w.Status = WalletTxStatusPending
+		walletTx, err = uuc.walletTxRepo.CreateWalletTx(ctx, w)
+		if nil != err {
+			return err
+		}
+
+		if 0 < w.WithdrawId { // 发送前记录，发送结果未知时也能追踪
+			return uuc.ubRepo.UpdateWithdrawTxHash(ctx, w.WithdrawId, w.TxHash)
+		}
+		return nil
+	}); nil != err {
+		return "", err
+	}
+
+	err = uuc.withdrawChain.SendTransaction(ctx, signedTx)
+	if nil == err || strings.Contains(err.Error(), "already known") || strings.Contains(err.Error(), "known transaction") {
+		return walletTx.TxHash, nil
+	}
+
+	// 发送失败回收nonce，下次重试继续使用；回收不了时用取消交易补上空缺
+	if txErr := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		released, e := uuc.walletTxRepo.ReleaseNonce(ctx, walletTx.Address, walletTx.Nonce)
+		if nil != e {
+			return e
+		}
+		if released {
+			return uuc.walletTxRepo.UpdateWalletTxStatus(ctx, walletTx.ID, WalletTxStatusPending, WalletTxStatusReleased)
+		}
+		return uuc.walletTxRepo.UpdateWalletTxStatus(ctx, walletTx.ID, WalletTxStatusPending, WalletTxStatusGap)
+	}); nil != txErr {
+		uuc.log.Errorf("wallet tx %d release: %v", walletTx.ID, txErr)
+	}
+
+	return "", err
+}
+
+// signWalletTx 按记录内容签名
+func (uuc *UserUseCase) signWalletTx(ctx context.Context, w *WalletTx, chainId *big.Int) (*types.Transaction, error) {
+	value, ok := new(big.Int).SetString(w.Value, 10)
+	if !ok {
+		return nil, errors.New(500, "WALLET_TX_ERROR", "交易金额错误")
+	}
+	gasPrice, ok := new(big.Int).SetString(w.GasPrice, 10)
+	if !ok {
+		return nil, errors.New(500, "WALLET_TX_ERROR", "gas价格错误")
+	}
+	data, err := hexutil.Decode(w.Data)
+	if nil != err && "" != w.Data && "0x" != w.Data {
+		return nil, errors.New(500, "WALLET_TX_ERROR", "交易数据错误")
+	}
+
+	tx := types.NewTransaction(uint64(w.Nonce), common.HexToAddress(w.ToAddress), value, uint64(w.GasLimit), gasPrice, data)
+
+	// 补gas时带上充值地址的派生索引，签名服务据此校验收款地址
+	if gasSigner, ok := uuc.signer.(GasSigner); ok && WalletTxKindGas == w.Kind {
+		depositAddress, err := uuc.depositAddressRepo.GetDepositAddressByAddress(ctx, w.ToAddress)
+		if nil != err {
+			return nil, err
+		}
+		return gasSigner.SignGasTx(ctx, depositAddress.PathIndex, tx, chainId)
+	}
+
+	return uuc.signer.SignTx(ctx, tx, chainId)
+}
+
+// replaceWalletTx 同nonce提高gas重新发送，cancel为true时改为转给自己0
+func (uuc *UserUseCase) replaceWalletTx(ctx context.Context, old *WalletTx, cancel bool) (*WalletTx, error) {
+	var (
+		chainId  *big.Int
+		gasPrice *big.Int
+		signedTx *types.Transaction
+		walletTx *WalletTx
+		err      error
+	)
+
+	chainId, err = uuc.withdrawChain.GetChainId(ctx)
+	if nil != err {
+		return nil, err
+	}
+	gasPrice, err = uuc.withdrawChain.SuggestGasPrice(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	// 节点要求替换交易的gas至少提高10%
+	oldGasPrice, _ := new(big.Int).SetString(old.GasPrice, 10)
+	if nil != oldGasPrice {
+		bumped := new(big.Int).Div(new(big.Int).Mul(oldGasPrice, big.NewInt(112)), big.NewInt(100))
+		bumped.Add(bumped, big.NewInt(1))
+		if 0 < bumped.Cmp(gasPrice) {
+			gasPrice = bumped
+		}
+	}
+
+	w := &WalletTx{
+		Address:    old.Address,
+		Nonce:      old.Nonce,
+		WithdrawId: old.WithdrawId,
+		Kind:       old.Kind,
+		ToAddress:  old.ToAddress,
+		Value:      old.Value,
+		Data:       old.Data,
+		GasLimit:   old.GasLimit,
+		GasPrice:   gasPrice.String(),
+		Status:     WalletTxStatusPending,
+	}
+	if cancel {
+		w.Kind = WalletTxKindCancel
+		w.ToAddress = old.Address
+		w.Value = "0"
+		w.Data = ""
+		w.GasLimit = 21000
+	}
+
+	signedTx, err = uuc.signWalletTx(ctx, w, chainId)
+	if nil != err {
+		return nil, err
+	}
+	w.TxHash = signedTx.Hash().Hex()
+
+	err = uuc.withdrawChain.SendTransaction(ctx, signedTx)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.walletTxRepo.UpdateWalletTxStatus(ctx, old.ID, old.Status, WalletTxStatusReplaced)
+		if nil != err {
+			return err
+		}
+
+		walletTx, err = uuc.walletTxRepo.CreateWalletTx(ctx, w)
+		if nil != err {
+			return err
+		}
+
+		if 0 < w.WithdrawId && WalletTxKindTransfer == w.Kind {
+			return uuc.ubRepo.UpdateWithdrawTxHash(ctx, w.WithdrawId, w.TxHash)
+		}
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return walletTx, nil
+}
+
+// AdminWalletTxReplace 加速或取消指定的未上链交易
+func (uuc *UserUseCase) AdminWalletTxReplace(ctx context.Context, req *v1.AdminWalletTxReplaceRequest) (*v1.AdminWalletTxReplaceReply, error) {
+	var (
+		walletTx *WalletTx
+		err      error
+	)
+
+	res := &v1.AdminWalletTxReplaceReply{}
+
+	if "speed_up" != req.SendBody.Type && "cancel" != req.SendBody.Type {
+		return res, errors.New(500, "WALLET_TX_ERROR", "替换类型错误")
+	}
+
+	walletTx, err = uuc.walletTxRepo.GetWalletTxById(ctx, req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if WalletTxStatusPending != walletTx.Status && WalletTxStatusGap != walletTx.Status {
+		return res, errors.New(500, "WALLET_TX_ERROR", "交易不是待上链状态")
+	}
+
+	walletTx, err = uuc.replaceWalletTx(ctx, walletTx, "cancel" == req.SendBody.Type)
+	if nil != err {
+		return res, err
+	}
+
+	res.TxHash = walletTx.TxHash
+	return res, nil
+}
+
+// AdminWalletTxCheck 已上链的nonce标记完成，超时未上链的交易提高gas重发
+func (uuc *UserUseCase) AdminWalletTxCheck(ctx context.Context, req *v1.AdminWalletTxCheckRequest) (*v1.AdminWalletTxCheckReply, error) {
+	var (
+		configs      []*Config
+		stuckSeconds int64 = 600
+		walletTxs    []*WalletTx
+		minedNonce   int64
+		err          error
+	)
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "wallet_tx_stuck_seconds")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "wallet_tx_stuck_seconds" == vConfig.KeyName {
+				stuckSeconds, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	address := strings.ToLower(uuc.signer.Address().Hex())
+	walletTxs, err = uuc.walletTxRepo.GetWalletTxsByStatus(ctx, address, WalletTxStatusPending, WalletTxStatusGap)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= len(walletTxs) {
+		return &v1.AdminWalletTxCheckReply{}, nil
+	}
+
+	minedNonce, err = uuc.withdrawChain.GetNonceAt(ctx, address, false)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, walletTx := range walletTxs {
+		if walletTx.Nonce < minedNonce {
+			if err = uuc.walletTxRepo.UpdateWalletTxStatus(ctx, walletTx.ID, walletTx.Status, WalletTxStatusMined); nil != err {
+				uuc.log.Errorf("wallet tx %d mined: %v", walletTx.ID, err)
+			}
+			continue
+		}
+
+		// nonce空缺立即用取消交易补上，否则后面的交易都无法上链
+		if WalletTxStatusGap != walletTx.Status && time.Now().UTC().Sub(walletTx.CreatedAt) < time.Duration(stuckSeconds)*time.Second {
+			continue
+		}
+
+		if _, err = uuc.replaceWalletTx(ctx, walletTx, WalletTxStatusGap == walletTx.Status); nil != err {
+			uuc.log.Errorf("wallet tx %d replace: %v", walletTx.ID, err)
+		}
+	}
+
+	return &v1.AdminWalletTxCheckReply{}, nil
+}
+
+// withdrawReplacedReceipt 打款交易被替换或取消时，查找同一提现已上链的那笔交易
+func (uuc *UserUseCase) withdrawReplacedReceipt(ctx context.Context, withdraw *Withdraw) (*TxReceipt, error) {
+	walletTxs, err := uuc.walletTxRepo.GetWalletTxsByWithdrawId(ctx, withdraw.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	// 只看和当前交易同nonce的记录
+	nonce := int64(-1)
+	for _, walletTx := range walletTxs {
+		if walletTx.TxHash == withdraw.TxHash {
+			nonce = walletTx.Nonce
+		}
+	}
+
+	for _, walletTx := range walletTxs {
+		if walletTx.TxHash == withdraw.TxHash || walletTx.Nonce != nonce {
+			continue
+		}
+
+		receipt, err := uuc.withdrawChain.GetTransactionReceipt(ctx, walletTx.TxHash)
+		if nil != err {
+			return nil, err
+		}
+		if nil == receipt {
+			continue
+		}
+
+		if WalletTxKindCancel == walletTx.Kind { // 已取消，按失败处理
+			receipt.Status = 0
+			return receipt, nil
+		}
+
+		if err = uuc.ubRepo.UpdateWithdrawTxHash(ctx, withdraw.ID, walletTx.TxHash); nil != err {
+			return nil, err
+		}
+		withdraw.TxHash = walletTx.TxHash
+		return receipt, nil
+	}
+
+	return nil, nil
+}
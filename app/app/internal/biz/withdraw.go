@@ -0,0 +1,444 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WithdrawStatus 提现状态
+type WithdrawStatus string
+
+const (
+	WithdrawStatusApply     WithdrawStatus = ""          // 用户申请，余额已扣除
+	WithdrawStatusRewarded  WithdrawStatus = "rewarded"  // 已扣手续费，待审核
+	WithdrawStatusPass      WithdrawStatus = "pass"      // 审核通过，待打款
+	WithdrawStatusDoing     WithdrawStatus = "doing"     // 打款中
+	WithdrawStatusSuccess   WithdrawStatus = "success"   // 打款交易已发送，等待确认
+	WithdrawStatusConfirmed WithdrawStatus = "confirmed" // 打款交易已确认
+	WithdrawStatusFailed    WithdrawStatus = "failed"    // 打款失败，可重新审核或退回
+	WithdrawStatusRefunded  WithdrawStatus = "refunded"  // 打款失败后退回余额
+	WithdrawStatusRejected  WithdrawStatus = "rejected"  // 审核拒绝，退回余额
+)
+
+// withdrawTransitions 允许的状态变更
+var withdrawTransitions = map[WithdrawStatus][]WithdrawStatus{
+	WithdrawStatusApply:    {WithdrawStatusRewarded, WithdrawStatusRejected},
+	WithdrawStatusRewarded: {WithdrawStatusPass, WithdrawStatusRejected},
+	WithdrawStatusPass:     {WithdrawStatusDoing, WithdrawStatusRejected},
+	WithdrawStatusDoing:    {WithdrawStatusSuccess, WithdrawStatusFailed},
+	WithdrawStatusSuccess:  {WithdrawStatusConfirmed, WithdrawStatusFailed},
+	WithdrawStatusFailed:   {WithdrawStatusPass, WithdrawStatusRefunded},
+}
+
+// CanTransit 是否允许变更到to
+func (s WithdrawStatus) CanTransit(to WithdrawStatus) bool {
+	for _, v := range withdrawTransitions[s] {
+		if to == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Refund 该状态是否需要把提现金额退回余额
+func (s WithdrawStatus) Refund() bool {
+	return WithdrawStatusRefunded == s || WithdrawStatusRejected == s
+}
+
+// WithdrawEvent 提现状态变更记录
+type WithdrawEvent struct {
+	ID         int64
+	WithdrawId int64
+	FromStatus WithdrawStatus
+	ToStatus   WithdrawStatus
+	Remark     string
+	CreatedAt  time.Time
+}
+
+// TxReceipt 链上交易回执
+type TxReceipt struct {
+	Hash        string
+	Status      uint64 // 1成功，0执行失败
+	BlockNumber int64
+	BlockHash   string
+}
+
+// WithdrawChain 打款交易所在链的查询
+type WithdrawChain interface {
+	GetChainId(ctx context.Context) (*big.Int, error)
+	GetLatestBlockNumber(ctx context.Context) (int64, error)
+	// GetNonceAt pending为false时返回已上链的交易数
+	GetNonceAt(ctx context.Context, address string, pending bool) (int64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	// GetTransactionReceipt 交易未上链时返回nil
+	GetTransactionReceipt(ctx context.Context, hash string) (*TxReceipt, error)
+	// GetBlockHash 区块不存在时返回空
+	GetBlockHash(ctx context.Context, blockNumber int64) (string, error)
+	GetBalance(ctx context.Context, address string) (*big.Int, error)
+	GetTokenBalance(ctx context.Context, contract string, address string) (*big.Int, error)
+}
+
+// transitWithdraw 变更提现状态并记录，需在事务中调用；状态已被其他流程修改时返回错误
+func (uuc *UserUseCase) transitWithdraw(ctx context.Context, withdraw *Withdraw, to WithdrawStatus, remark string) error {
+	var err error
+
+	if !withdraw.Status.CanTransit(to) {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "提现状态不允许变更")
+	}
+
+	if WithdrawStatusFailed == withdraw.Status { // 之前的打款交易仍可能上链，防止重复打款
+		if err = uuc.checkWithdrawUnpaid(ctx, withdraw); nil != err {
+			return err
+		}
+	}
+
+	if WithdrawStatusRewarded == to { // 审核前写入实际到账金额
+		err = uuc.ubRepo.UpdateWithdrawAmount(ctx, withdraw.ID, withdraw.Status, to, withdraw.RelAmount)
+	} else {
+		err = uuc.ubRepo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, to)
+	}
+	if nil != err {
+		return err
+	}
+
+	err = uuc.ubRepo.CreateWithdrawEvent(ctx, &WithdrawEvent{
+		WithdrawId: withdraw.ID,
+		FromStatus: withdraw.Status,
+		ToStatus:   to,
+		Remark:     remark,
+	})
+	if nil != err {
+		return err
+	}
+
+	if to.Refund() {
+		var fee int64
+		fee, err = uuc.ubRepo.GetSystemFeeByWithdrawId(ctx, withdraw.ID)
+		if nil != err {
+			return err
+		}
+
+		// 已收取的手续费一并退回，余额按申请金额全额退回
+		if 0 < fee {
+			err = uuc.ubRepo.SystemFeeRefund(ctx, fee, withdraw.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		_, err = uuc.ubRepo.RefundWithdraw(ctx, withdraw.ID, withdraw.UserId, withdraw.Amount, withdraw.Type)
+		if nil != err {
+			return err
+		}
+	}
+
+	withdraw.Status = to
+	return nil
+}
+
+// checkWithdrawUnpaid 打款失败的提现重新打款或退回前，确认打款交易的nonce都已上链且没有打款成功
+func (uuc *UserUseCase) checkWithdrawUnpaid(ctx context.Context, withdraw *Withdraw) error {
+	walletTxs, err := uuc.walletTxRepo.GetWalletTxsByWithdrawId(ctx, withdraw.ID)
+	if nil != err {
+		return err
+	}
+
+	minedNonces := make(map[string]int64, 0)
+	for _, walletTx := range walletTxs {
+		if WalletTxStatusPending != walletTx.Status && WalletTxStatusGap != walletTx.Status {
+			continue
+		}
+
+		minedNonce, ok := minedNonces[walletTx.Address]
+		if !ok {
+			minedNonce, err = uuc.withdrawChain.GetNonceAt(ctx, walletTx.Address, false)
+			if nil != err {
+				return err
+			}
+			minedNonces[walletTx.Address] = minedNonce
+		}
+		if walletTx.Nonce >= minedNonce {
+			return errors.New(500, "WITHDRAW_STATUS_ERROR", "打款交易未上链，不能重新打款或退回")
+		}
+	}
+
+	// 发送报错的交易也可能已广播，逐笔查回执
+	for _, walletTx := range walletTxs {
+		if WalletTxKindTransfer != walletTx.Kind {
+			continue
+		}
+
+		receipt, err := uuc.withdrawChain.GetTransactionReceipt(ctx, walletTx.TxHash)
+		if nil != err {
+			return err
+		}
+		if nil != receipt && 1 == receipt.Status {
+			return errors.New(500, "WITHDRAW_STATUS_ERROR", "打款交易已上链成功，不能重新打款或退回")
+		}
+	}
+
+	return nil
+}
+
+// updateWithdrawStatus 按id变更提现状态
+func (uuc *UserUseCase) updateWithdrawStatus(ctx context.Context, id int64, to WithdrawStatus, remark string) error {
+	withdraw, err := uuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return err
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.transitWithdraw(ctx, withdraw, to, remark)
+	})
+}
+
+// AdminWithdrawReject 拒绝提现，退回余额和手续费
+func (uuc *UserUseCase) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	res := &v1.AdminWithdrawRejectReply{}
+
+	reason := strings.TrimSpace(req.SendBody.Reason)
+	if "" == reason {
+		return res, errors.New(500, "WITHDRAW_REJECT_ERROR", "请填写拒绝原因")
+	}
+	if 255 < len(reason) {
+		return res, errors.New(500, "WITHDRAW_REJECT_ERROR", "拒绝原因过长")
+	}
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.transitWithdraw(ctx, withdraw, WithdrawStatusRejected, reason)
+		if nil != err {
+			return err
+		}
+
+		return uuc.ubRepo.UpdateWithdrawReason(ctx, withdraw.ID, reason)
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawRefund 打款失败的提现退回余额和手续费
+func (uuc *UserUseCase) AdminWithdrawRefund(ctx context.Context, req *v1.AdminWithdrawRefundRequest) (*v1.AdminWithdrawRefundReply, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	res := &v1.AdminWithdrawRefundReply{}
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if WithdrawStatusFailed != withdraw.Status {
+		return res, errors.New(500, "WITHDRAW_STATUS_ERROR", "只有打款失败的提现可以退回")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.transitWithdraw(ctx, withdraw, WithdrawStatusRefunded, "admin refund")
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) error {
+	return uuc.updateWithdrawStatus(ctx, id, WithdrawStatusDoing, "")
+}
+
+func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) error {
+	return uuc.updateWithdrawStatus(ctx, id, WithdrawStatusSuccess, "")
+}
+
+func (uuc *UserUseCase) UpdateWithdrawFailed(ctx context.Context, id int64, remark string) error {
+	return uuc.updateWithdrawStatus(ctx, id, WithdrawStatusFailed, remark)
+}
+
+// AdminWithdrawTrack 查询已发送打款的回执，确认数足够后完成，链上执行失败的重新打款或退回
+func (uuc *UserUseCase) AdminWithdrawTrack(ctx context.Context, req *v1.AdminWithdrawTrackRequest) (*v1.AdminWithdrawTrackReply, error) {
+	var (
+		configs       []*Config
+		confirmations int64 = 15
+		maxRetry      int64 = 3
+		doingSeconds  int64 = 600
+		withdraws     []*Withdraw
+		latestBlock   int64
+		receipt       *TxReceipt
+		err           error
+	)
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "withdraw_confirmations", "withdraw_max_retry", "withdraw_doing_seconds")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_confirmations" == vConfig.KeyName {
+				confirmations, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "withdraw_max_retry" == vConfig.KeyName {
+				maxRetry, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "withdraw_doing_seconds" == vConfig.KeyName {
+				doingSeconds, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	if err = uuc.withdrawDoingRecover(ctx, doingSeconds); nil != err {
+		return nil, err
+	}
+
+	withdraws, err = uuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusSuccess)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= len(withdraws) {
+		return &v1.AdminWithdrawTrackReply{}, nil
+	}
+
+	latestBlock, err = uuc.withdrawChain.GetLatestBlockNumber(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, withdraw := range withdraws {
+		if "" == withdraw.TxHash {
+			continue
+		}
+
+		receipt, err = uuc.withdrawChain.GetTransactionReceipt(ctx, withdraw.TxHash)
+		if nil != err {
+			uuc.log.Errorf("withdraw %d receipt: %v", withdraw.ID, err)
+			continue
+		}
+		if nil == receipt { // 可能已被替换或取消
+			receipt, err = uuc.withdrawReplacedReceipt(ctx, withdraw)
+			if nil != err {
+				uuc.log.Errorf("withdraw %d receipt: %v", withdraw.ID, err)
+				continue
+			}
+		}
+		if nil == receipt { // 未上链
+			continue
+		}
+
+		if 1 == receipt.Status {
+			if latestBlock-receipt.BlockNumber+1 < confirmations {
+				continue
+			}
+
+			if err = uuc.updateWithdrawStatus(ctx, withdraw.ID, WithdrawStatusConfirmed, withdraw.TxHash); nil != err {
+				uuc.log.Errorf("withdraw %d confirm: %v", withdraw.ID, err)
+			}
+			continue
+		}
+
+		if err = uuc.withdrawReverted(ctx, withdraw, maxRetry); nil != err {
+			uuc.log.Errorf("withdraw %d reverted: %v", withdraw.ID, err)
+		}
+	}
+
+	return &v1.AdminWithdrawTrackReply{}, nil
+}
+
+// withdrawReverted 链上执行失败，未超过重试次数时重新进入待打款，否则退回余额
+func (uuc *UserUseCase) withdrawReverted(ctx context.Context, withdraw *Withdraw, maxRetry int64) error {
+	events, err := uuc.ubRepo.GetWithdrawEvents(ctx, withdraw.ID)
+	if nil != err {
+		return err
+	}
+
+	var failedNum int64
+	for _, v := range events {
+		if WithdrawStatusFailed == v.ToStatus {
+			failedNum++
+		}
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.transitWithdraw(ctx, withdraw, WithdrawStatusFailed, "reverted "+withdraw.TxHash)
+		if nil != err {
+			return err
+		}
+
+		if failedNum < maxRetry {
+			return uuc.transitWithdraw(ctx, withdraw, WithdrawStatusPass, "retry")
+		}
+		return uuc.transitWithdraw(ctx, withdraw, WithdrawStatusRefunded, "")
+	})
+}
+
+// withdrawDoingRecover 打款中途进程退出的提现：已记录打款交易的转入待确认由回执跟踪，没有记录的转为失败
+func (uuc *UserUseCase) withdrawDoingRecover(ctx context.Context, doingSeconds int64) error {
+	withdraws, err := uuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusDoing)
+	if nil != err {
+		return err
+	}
+
+	for _, withdraw := range withdraws {
+		var events []*WithdrawEvent
+		events, err = uuc.ubRepo.GetWithdrawEvents(ctx, withdraw.ID)
+		if nil != err {
+			return err
+		}
+
+		// 打款流程可能还在重试
+		var doingAt time.Time
+		for _, v := range events {
+			if WithdrawStatusDoing == v.ToStatus && v.CreatedAt.After(doingAt) {
+				doingAt = v.CreatedAt
+			}
+		}
+		if time.Now().UTC().Sub(doingAt) < time.Duration(doingSeconds)*time.Second {
+			continue
+		}
+
+		var walletTxs []*WalletTx
+		walletTxs, err = uuc.walletTxRepo.GetWalletTxsByWithdrawId(ctx, withdraw.ID)
+		if nil != err {
+			return err
+		}
+
+		var last *WalletTx
+		for _, walletTx := range walletTxs {
+			if WalletTxKindTransfer != walletTx.Kind || WalletTxStatusReleased == walletTx.Status {
+				continue
+			}
+			if nil == last || last.ID < walletTx.ID {
+				last = walletTx
+			}
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			if nil == last {
+				return uuc.transitWithdraw(ctx, withdraw, WithdrawStatusFailed, "interrupted")
+			}
+
+			if err := uuc.ubRepo.UpdateWithdrawTxHash(ctx, withdraw.ID, last.TxHash); nil != err {
+				return err
+			}
+			withdraw.TxHash = last.TxHash
+			return uuc.transitWithdraw(ctx, withdraw, WithdrawStatusSuccess, "interrupted")
+		}); nil != err {
+			uuc.log.Errorf("withdraw %d recover: %v", withdraw.ID, err)
+		}
+	}
+
+	return nil
+}
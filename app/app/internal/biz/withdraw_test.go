@@ -0,0 +1,430 @@
+package biz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testWalletKey, _ = crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	testUsdt         = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	testRevertToken  = common.HexToAddress("0x6504631df9F6FF397b0ec442FB80685a7B1688d4")
+	testPayee        = "0x1111111111111111111111111111111111111111"
+)
+
+// simWithdrawChain 用go-ethereum的模拟链实现WithdrawChain，每次commit出一个块
+type simWithdrawChain struct {
+	b       *backends.SimulatedBackend
+	block   int64
+	pending []common.Hash
+	mined   map[common.Hash]int64
+}
+
+func newSimWithdrawChain() *simWithdrawChain {
+	return &simWithdrawChain{
+		b: backends.NewSimulatedBackend(core.GenesisAlloc{
+			crypto.PubkeyToAddress(testWalletKey.PublicKey): {Balance: big.NewInt(1000000000000000000)},
+			testRevertToken: {Code: []byte{0x60, 0x00, 0x60, 0x00, 0xfd}, Balance: big.NewInt(0)}, // revert(0, 0)
+		}, 100000000),
+		mined: make(map[common.Hash]int64, 0),
+	}
+}
+
+func (c *simWithdrawChain) commit() {
+	c.b.Commit()
+	c.block++
+	for _, hash := range c.pending {
+		c.mined[hash] = c.block
+	}
+	c.pending = nil
+}
+
+func (c *simWithdrawChain) GetChainId(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1337), nil
+}
+
+func (c *simWithdrawChain) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	return c.block, nil
+}
+
+func (c *simWithdrawChain) GetNonceAt(ctx context.Context, address string, pending bool) (int64, error) {
+	if pending {
+		nonce, err := c.b.PendingNonceAt(ctx, common.HexToAddress(address))
+		return int64(nonce), err
+	}
+	nonce, err := c.b.NonceAt(ctx, common.HexToAddress(address), nil)
+	return int64(nonce), err
+}
+
+func (c *simWithdrawChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.b.SuggestGasPrice(ctx)
+}
+
+func (c *simWithdrawChain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := c.b.SendTransaction(ctx, tx); nil != err {
+		return err
+	}
+	c.pending = append(c.pending, tx.Hash())
+	return nil
+}
+
+func (c *simWithdrawChain) GetTransactionReceipt(ctx context.Context, hash string) (*TxReceipt, error) {
+	receipt, err := c.b.TransactionReceipt(ctx, common.HexToHash(hash))
+	if nil != err || nil == receipt {
+		return nil, err
+	}
+	return &TxReceipt{Hash: hash, Status: receipt.Status, BlockNumber: c.mined[common.HexToHash(hash)]}, nil
+}
+
+func (c *simWithdrawChain) GetBlockHash(ctx context.Context, blockNumber int64) (string, error) {
+	return "", nil
+}
+
+func (c *simWithdrawChain) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	return c.b.BalanceAt(ctx, common.HexToAddress(address), nil)
+}
+
+func (c *simWithdrawChain) GetTokenBalance(ctx context.Context, contract string, address string) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// testWalletSigner 模拟链只认homestead签名
+type testWalletSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *testWalletSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *testWalletSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.HomesteadSigner{}, s.key)
+}
+
+type testTx struct{}
+
+func (t *testTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type testConfigRepo struct {
+	ConfigRepo
+	values map[string]string
+}
+
+func (r *testConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, k := range keys {
+		if v, ok := r.values[k]; ok {
+			res = append(res, &Config{KeyName: k, Value: v})
+		}
+	}
+	return res, nil
+}
+
+type testTokenRepo struct {
+	TokenRepo
+}
+
+func (r *testTokenRepo) GetTokenBySymbol(ctx context.Context, symbol string) (*Token, error) {
+	contract := testUsdt
+	if "revert" == symbol {
+		contract = testRevertToken
+	}
+	return &Token{Symbol: symbol, Contract: strings.ToLower(contract.Hex()), Decimals: 18, Status: 1}, nil
+}
+
+// testWithdrawRepo 只实现提现状态流转用到的方法
+type testWithdrawRepo struct {
+	UserBalanceRepo
+	withdraws map[int64]*Withdraw
+	events    []*WithdrawEvent
+	refunds   map[int64]int64
+}
+
+func (r *testWithdrawRepo) GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error) {
+	w, ok := r.withdraws[id]
+	if !ok {
+		return nil, fmt.Errorf("withdraw %d not found", id)
+	}
+	tmp := *w
+	return &tmp, nil
+}
+
+func (r *testWithdrawRepo) GetWithdrawsByStatus(ctx context.Context, status WithdrawStatus) ([]*Withdraw, error) {
+	res := make([]*Withdraw, 0)
+	for _, w := range r.withdraws {
+		if status == w.Status {
+			tmp := *w
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *testWithdrawRepo) UpdateWithdrawStatus(ctx context.Context, id int64, from WithdrawStatus, to WithdrawStatus) error {
+	w := r.withdraws[id]
+	if from != w.Status {
+		return fmt.Errorf("withdraw %d status %q, want %q", id, w.Status, from)
+	}
+	w.Status = to
+	return nil
+}
+
+func (r *testWithdrawRepo) UpdateWithdrawTxHash(ctx context.Context, id int64, txHash string) error {
+	r.withdraws[id].TxHash = txHash
+	return nil
+}
+
+func (r *testWithdrawRepo) CreateWithdrawEvent(ctx context.Context, e *WithdrawEvent) error {
+	e.CreatedAt = time.Now().UTC()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *testWithdrawRepo) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*WithdrawEvent, error) {
+	res := make([]*WithdrawEvent, 0)
+	for _, e := range r.events {
+		if withdrawId == e.WithdrawId {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+func (r *testWithdrawRepo) GetSystemFeeByWithdrawId(ctx context.Context, withdrawId int64) (int64, error) {
+	return 0, nil
+}
+
+func (r *testWithdrawRepo) RefundWithdraw(ctx context.Context, withdrawId int64, userId int64, amount int64, coinType string) (int64, error) {
+	r.refunds[withdrawId] += amount
+	return 0, nil
+}
+
+type testWalletTxRepo struct {
+	nonces    map[string]int64
+	walletTxs []*WalletTx
+}
+
+func (r *testWalletTxRepo) AllocateNonce(ctx context.Context, address string, chainNonce int64) (int64, error) {
+	nonce, ok := r.nonces[address]
+	if !ok || nonce < chainNonce {
+		nonce = chainNonce
+	}
+	r.nonces[address] = nonce + 1
+	return nonce, nil
+}
+
+func (r *testWalletTxRepo) ReleaseNonce(ctx context.Context, address string, nonce int64) (bool, error) {
+	if r.nonces[address] != nonce+1 {
+		return false, nil
+	}
+	r.nonces[address] = nonce
+	return true, nil
+}
+
+func (r *testWalletTxRepo) CreateWalletTx(ctx context.Context, w *WalletTx) (*WalletTx, error) {
+	tmp := *w
+	tmp.ID = int64(len(r.walletTxs) + 1)
+	tmp.CreatedAt = time.Now().UTC()
+	r.walletTxs = append(r.walletTxs, &tmp)
+	return &tmp, nil
+}
+
+func (r *testWalletTxRepo) UpdateWalletTxStatus(ctx context.Context, id int64, from string, to string) error {
+	w := r.walletTxs[id-1]
+	if from != w.Status {
+		return fmt.Errorf("wallet tx %d status %q, want %q", id, w.Status, from)
+	}
+	w.Status = to
+	return nil
+}
+
+func (r *testWalletTxRepo) GetWalletTxById(ctx context.Context, id int64) (*WalletTx, error) {
+	tmp := *r.walletTxs[id-1]
+	return &tmp, nil
+}
+
+func (r *testWalletTxRepo) GetWalletTxsByStatus(ctx context.Context, address string, status ...string) ([]*WalletTx, error) {
+	res := make([]*WalletTx, 0)
+	for _, w := range r.walletTxs {
+		for _, s := range status {
+			if address == w.Address && s == w.Status {
+				tmp := *w
+				res = append(res, &tmp)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *testWalletTxRepo) GetWalletTxsByWithdrawId(ctx context.Context, withdrawId int64) ([]*WalletTx, error) {
+	res := make([]*WalletTx, 0)
+	for _, w := range r.walletTxs {
+		if withdrawId == w.WithdrawId {
+			tmp := *w
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+type withdrawTestEnv struct {
+	uuc      *UserUseCase
+	chain    *simWithdrawChain
+	withdraw *testWithdrawRepo
+}
+
+func newWithdrawTestEnv(withdraws ...*Withdraw) *withdrawTestEnv {
+	env := &withdrawTestEnv{
+		chain:    newSimWithdrawChain(),
+		withdraw: &testWithdrawRepo{withdraws: make(map[int64]*Withdraw, 0), refunds: make(map[int64]int64, 0)},
+	}
+	for _, w := range withdraws {
+		env.withdraw.withdraws[w.ID] = w
+		// 进入当前状态的记录，打款中的按很久以前开始
+		env.withdraw.events = append(env.withdraw.events, &WithdrawEvent{WithdrawId: w.ID, ToStatus: w.Status, CreatedAt: time.Now().UTC().Add(-time.Hour)})
+	}
+	env.uuc = &UserUseCase{
+		configRepo:    &testConfigRepo{values: map[string]string{"withdraw_confirmations": "2", "withdraw_doing_seconds": "600"}},
+		ubRepo:        env.withdraw,
+		withdrawChain: env.chain,
+		walletTxRepo:  &testWalletTxRepo{nonces: make(map[string]int64, 0)},
+		tokenRepo:     &testTokenRepo{},
+		signer:        &testWalletSigner{key: testWalletKey},
+		tx:            &testTx{},
+		log:           log.NewHelper(log.DefaultLogger),
+	}
+	return env
+}
+
+func (env *withdrawTestEnv) transfer(t *testing.T, id int64) {
+	t.Helper()
+	w, err := env.withdraw.GetWithdrawById(context.Background(), id)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if _, err = env.uuc.WithdrawTransfer(context.Background(), w, testPayee); nil != err {
+		t.Fatal(err)
+	}
+}
+
+func (env *withdrawTestEnv) track(t *testing.T) {
+	t.Helper()
+	if _, err := env.uuc.AdminWithdrawTrack(context.Background(), &v1.AdminWithdrawTrackRequest{}); nil != err {
+		t.Fatal(err)
+	}
+}
+
+func (env *withdrawTestEnv) assertStatus(t *testing.T, id int64, status WithdrawStatus) {
+	t.Helper()
+	if status != env.withdraw.withdraws[id].Status {
+		t.Fatalf("withdraw %d status %q, want %q", id, env.withdraw.withdraws[id].Status, status)
+	}
+}
+
+func TestWithdrawTrackConfirmed(t *testing.T) {
+	env := newWithdrawTestEnv(&Withdraw{ID: 1, UserId: 1, Amount: 100, RelAmount: 100, Status: WithdrawStatusDoing, Type: "usdt"})
+	ctx := context.Background()
+
+	env.transfer(t, 1)
+	if err := env.uuc.UpdateWithdrawSuccess(ctx, 1); nil != err {
+		t.Fatal(err)
+	}
+
+	env.track(t) // 未上链
+	env.assertStatus(t, 1, WithdrawStatusSuccess)
+
+	env.chain.commit()
+	env.track(t) // 确认数不够
+	env.assertStatus(t, 1, WithdrawStatusSuccess)
+
+	env.chain.commit()
+	env.track(t)
+	env.assertStatus(t, 1, WithdrawStatusConfirmed)
+}
+
+func TestWithdrawTrackReverted(t *testing.T) {
+	env := newWithdrawTestEnv(&Withdraw{ID: 1, UserId: 1, Amount: 100, RelAmount: 100, Status: WithdrawStatusDoing, Type: "revert"})
+	ctx := context.Background()
+
+	env.transfer(t, 1)
+	if err := env.uuc.UpdateWithdrawSuccess(ctx, 1); nil != err {
+		t.Fatal(err)
+	}
+	env.chain.commit()
+
+	env.track(t) // 链上执行失败，重新进入待打款
+	env.assertStatus(t, 1, WithdrawStatusPass)
+}
+
+func TestWithdrawDoingRecover(t *testing.T) {
+	env := newWithdrawTestEnv(
+		&Withdraw{ID: 1, UserId: 1, Amount: 100, RelAmount: 100, Status: WithdrawStatusDoing, Type: "usdt"},
+		&Withdraw{ID: 2, UserId: 1, Amount: 100, RelAmount: 100, Status: WithdrawStatusDoing, Type: "usdt"},
+	)
+
+	// 1已发出打款交易后进程退出，2还没发出
+	env.transfer(t, 1)
+	env.chain.commit()
+	env.chain.commit()
+
+	env.track(t)
+	env.assertStatus(t, 1, WithdrawStatusConfirmed)
+	env.assertStatus(t, 2, WithdrawStatusFailed)
+
+	// 没有打款交易的可以退回
+	if _, err := env.uuc.AdminWithdrawRefund(context.Background(), &v1.AdminWithdrawRefundRequest{SendBody: &v1.AdminWithdrawRefundRequest_SendBody{Id: 2}}); nil != err {
+		t.Fatal(err)
+	}
+	env.assertStatus(t, 2, WithdrawStatusRefunded)
+	if 100 != env.withdraw.refunds[2] {
+		t.Fatalf("refund %d, want 100", env.withdraw.refunds[2])
+	}
+}
+
+func TestWithdrawFailedNotPaidTwice(t *testing.T) {
+	env := newWithdrawTestEnv(&Withdraw{ID: 1, UserId: 1, Amount: 100, RelAmount: 100, Status: WithdrawStatusDoing, Type: "usdt"})
+	ctx := context.Background()
+	pass := &v1.AdminWithdrawPassRequest{SendBody: &v1.AdminWithdrawPassRequest_SendBody{Id: 1}}
+	refund := &v1.AdminWithdrawRefundRequest{SendBody: &v1.AdminWithdrawRefundRequest_SendBody{Id: 1}}
+
+	// 交易已广播但发送结果未知，提现被标记为失败
+	env.transfer(t, 1)
+	if err := env.uuc.UpdateWithdrawFailed(ctx, 1, "timeout"); nil != err {
+		t.Fatal(err)
+	}
+
+	// 未上链
+	if _, err := env.uuc.AdminWithdrawPass(ctx, pass); nil == err {
+		t.Fatal("pass with pending transfer: want error")
+	}
+	if _, err := env.uuc.AdminWithdrawRefund(ctx, refund); nil == err {
+		t.Fatal("refund with pending transfer: want error")
+	}
+
+	// 已打款成功
+	env.chain.commit()
+	if _, err := env.uuc.AdminWithdrawPass(ctx, pass); nil == err {
+		t.Fatal("pass after paid: want error")
+	}
+	if _, err := env.uuc.AdminWithdrawRefund(ctx, refund); nil == err {
+		t.Fatal("refund after paid: want error")
+	}
+	env.assertStatus(t, 1, WithdrawStatusFailed)
+	if 0 != env.withdraw.refunds[1] {
+		t.Fatalf("refund %d, want 0", env.withdraw.refunds[1])
+	}
+}
@@ -0,0 +1,573 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// transferTopic erc20 Transfer(address,address,uint256) 事件签名
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+type DepositCursor struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Name        string    `gorm:"type:varchar(45);not null"`
+	BlockNumber int64     `gorm:"type:bigint;not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositCursorRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositCursorRepo(data *Data, logger log.Logger) biz.DepositCursorRepo {
+	return &DepositCursorRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetDepositCursor .
+func (d *DepositCursorRepo) GetDepositCursor(ctx context.Context, name string) (*biz.DepositCursor, error) {
+	var depositCursor DepositCursor
+	if err := d.data.DB(ctx).Table("deposit_cursor").Where("name=?", name).First(&depositCursor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("DEPOSIT_CURSOR_NOT_FOUND", "deposit cursor not found")
+		}
+
+		return nil, errors.New(500, "DEPOSIT CURSOR ERROR", err.Error())
+	}
+
+	return &biz.DepositCursor{
+		ID:          depositCursor.ID,
+		Name:        depositCursor.Name,
+		BlockNumber: depositCursor.BlockNumber,
+	}, nil
+}
+
+// UpdateDepositCursor 不存在时创建
+func (d *DepositCursorRepo) UpdateDepositCursor(ctx context.Context, name string, blockNumber int64) error {
+	res := d.data.DB(ctx).Table("deposit_cursor").Where("name=?", name).
+		Updates(map[string]interface{}{"block_number": blockNumber})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_DEPOSIT_CURSOR_ERROR", "充值游标修改失败")
+	}
+	if 0 < res.RowsAffected {
+		return nil
+	}
+
+	var depositCursor DepositCursor
+	depositCursor.Name = name
+	depositCursor.BlockNumber = blockNumber
+	if err := d.data.DB(ctx).Table("deposit_cursor").Create(&depositCursor).Error; err != nil {
+		return errors.New(500, "CREATE_DEPOSIT_CURSOR_ERROR", "充值游标创建失败")
+	}
+
+	return nil
+}
+
+// NewDepositSource 根据配置选择充值数据来源，节点取链配置
+func NewDepositSource(c *conf.Deposit, chain *conf.Chain, logger log.Logger) biz.DepositSource {
+	switch c.Source {
+	case "rpc":
+		return &RpcDepositSource{chain: chain, log: log.NewHelper(logger)}
+	case "fake":
+		return NewFakeDepositSource()
+	default:
+		var apiKey string
+		if nil != c.Bscscan {
+			apiKey = os.Getenv(c.Bscscan.ApiKeyEnv)
+		}
+		return &BscscanDepositSource{conf: c, apiKey: apiKey, log: log.NewHelper(logger)}
+	}
+}
+
+// parseHexInt bscscan返回的0值为"0x"
+func parseHexInt(s string) (int64, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if "" == s {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 16, 64)
+}
+
+// BscscanDepositSource 通过bscscan的logs接口获取转入记录
+type BscscanDepositSource struct {
+	conf   *conf.Deposit
+	apiKey string
+	log    *log.Helper
+
+	lock        sync.Mutex
+	lastRequest time.Time // 按rate_limit控制请求间隔
+}
+
+type bscscanLog struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	BlockNumber     string   `json:"blockNumber"`
+	BlockHash       string   `json:"blockHash"`
+	LogIndex        string   `json:"logIndex"`
+	TransactionHash string   `json:"transactionHash"`
+}
+
+// toTransfer 不是Transfer事件时返回nil
+func (v *bscscanLog) toTransfer() (*biz.DepositTransfer, error) {
+	if 3 != len(v.Topics) || !strings.EqualFold(transferTopic.Hex(), v.Topics[0]) {
+		return nil, nil
+	}
+	blockNumber, err := parseHexInt(v.BlockNumber)
+	if nil != err {
+		return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	logIndex, err := parseHexInt(v.LogIndex)
+	if nil != err {
+		return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(v.Data, "0x"), 16)
+	if !ok {
+		return nil, nil
+	}
+
+	return &biz.DepositTransfer{
+		Hash:        strings.ToLower(v.TransactionHash),
+		From:        strings.ToLower(common.HexToAddress(v.Topics[1]).Hex()),
+		To:          strings.ToLower(common.HexToAddress(v.Topics[2]).Hex()),
+		Value:       value.String(),
+		Contract:    strings.ToLower(v.Address),
+		BlockNumber: blockNumber,
+		BlockHash:   v.BlockHash,
+		LogIndex:    logIndex,
+	}, nil
+}
+
+// wait 距上次请求不足1/rate_limit秒时等待
+func (b *BscscanDepositSource) wait(ctx context.Context) error {
+	if 0 >= b.conf.Bscscan.RateLimit {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	next := b.lastRequest.Add(time.Second / time.Duration(b.conf.Bscscan.RateLimit))
+	if d := time.Until(next); 0 < d {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+		}
+	}
+	b.lastRequest = time.Now()
+
+	return nil
+}
+
+func (b *BscscanDepositSource) request(ctx context.Context, data url.Values, result interface{}) error {
+	if err := b.wait(ctx); nil != err {
+		return err
+	}
+	data.Set("apikey", b.apiKey)
+	u, err := url.ParseRequestURI(b.conf.Bscscan.Url)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = data.Encode() // URL encode
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	client := http.Client{
+		Timeout: 10 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(body, result); err != nil {
+		return errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetLatestBlockNumber .
+func (b *BscscanDepositSource) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	data := url.Values{}
+	data.Set("module", "proxy")
+	data.Set("action", "eth_blockNumber")
+
+	var i struct {
+		Result string `json:"result"`
+	}
+	if err := b.request(ctx, data, &i); nil != err {
+		return 0, err
+	}
+
+	blockNumber, err := parseHexInt(i.Result)
+	if nil != err {
+		return 0, errors.New(500, "DEPOSIT_SOURCE_ERROR", i.Result)
+	}
+
+	return blockNumber, nil
+}
+
+// bscscanMaxResults 接口翻页时page*offset不能超过10000
+const bscscanMaxResults = 10000
+
+// GetDepositTransfers 收款地址放在topic2由接口过滤，接口topic只能指定一个值，按地址逐个查询，地址多时用rpc分批查询
+func (b *BscscanDepositSource) GetDepositTransfers(ctx context.Context, contract string, to []string, fromBlock int64, toBlock int64) ([]*biz.DepositTransfer, error) {
+	res := make([]*biz.DepositTransfer, 0)
+	for _, vTo := range to {
+		transfers, err := b.getTransfers(ctx, contract, vTo, fromBlock, toBlock)
+		if nil != err {
+			return nil, err
+		}
+		res = append(res, transfers...)
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// getTransfers 转入to的Transfer，超出接口翻页上限时把区块范围对半拆开查询
+func (b *BscscanDepositSource) getTransfers(ctx context.Context, contract string, to string, fromBlock int64, toBlock int64) ([]*biz.DepositTransfer, error) {
+	var (
+		pageSize = 1000
+		res      = make([]*biz.DepositTransfer, 0)
+	)
+
+	// 接口单次最多返回1000条，翻页直到取完
+	for page := 1; ; page++ {
+		if bscscanMaxResults < page*pageSize {
+			if fromBlock >= toBlock {
+				return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", "单个区块的转账超过bscscan上限，请改用rpc")
+			}
+
+			mid := fromBlock + (toBlock-fromBlock)/2
+			left, err := b.getTransfers(ctx, contract, to, fromBlock, mid)
+			if nil != err {
+				return nil, err
+			}
+			right, err := b.getTransfers(ctx, contract, to, mid+1, toBlock)
+			if nil != err {
+				return nil, err
+			}
+			return append(left, right...), nil
+		}
+
+		data := url.Values{}
+		data.Set("module", "logs")
+		data.Set("action", "getLogs")
+		data.Set("fromBlock", strconv.FormatInt(fromBlock, 10))
+		data.Set("toBlock", strconv.FormatInt(toBlock, 10))
+		data.Set("address", contract)
+		data.Set("topic0", transferTopic.Hex())
+		data.Set("topic0_2_opr", "and")
+		data.Set("topic2", common.BytesToHash(common.HexToAddress(to).Bytes()).Hex())
+		data.Set("page", strconv.Itoa(page))
+		data.Set("offset", strconv.Itoa(pageSize))
+
+		var i struct {
+			Status  string          `json:"status"`
+			Message string          `json:"message"`
+			Result  json.RawMessage `json:"result"`
+		}
+		if err := b.request(ctx, data, &i); nil != err {
+			return nil, err
+		}
+
+		if "1" != i.Status {
+			if "No records found" == i.Message {
+				break
+			}
+			return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", i.Message+" "+string(i.Result))
+		}
+
+		var logs []*bscscanLog
+		if err := json.Unmarshal(i.Result, &logs); nil != err {
+			return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+		}
+
+		for _, v := range logs {
+			transfer, err := v.toTransfer()
+			if nil != err {
+				return nil, err
+			}
+			if nil != transfer {
+				res = append(res, transfer)
+			}
+		}
+
+		if pageSize > len(logs) {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// GetDepositTransfersByHash 交易回执中的全部代币转账，交易未成功时为空
+func (b *BscscanDepositSource) GetDepositTransfersByHash(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	data := url.Values{}
+	data.Set("module", "proxy")
+	data.Set("action", "eth_getTransactionReceipt")
+	data.Set("txhash", hash)
+
+	var i struct {
+		Result *struct {
+			Status string        `json:"status"`
+			Logs   []*bscscanLog `json:"logs"`
+		} `json:"result"`
+	}
+	if err := b.request(ctx, data, &i); nil != err {
+		return nil, err
+	}
+
+	res := make([]*biz.DepositTransfer, 0)
+	if nil == i.Result || "0x1" != i.Result.Status { // 未上链或失败
+		return res, nil
+	}
+	for _, v := range i.Result.Logs {
+		transfer, err := v.toTransfer()
+		if nil != err {
+			return nil, err
+		}
+		if nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// RpcDepositSource 通过节点的eth_getLogs获取转入记录
+type RpcDepositSource struct {
+	chain *conf.Chain
+	log   *log.Helper
+}
+
+// GetLatestBlockNumber .
+func (r *RpcDepositSource) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	client, err := ethclient.DialContext(ctx, r.chain.RpcUrl)
+	if err != nil {
+		return 0, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	defer client.Close()
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+
+	return header.Number.Int64(), nil
+}
+
+// rpcTopicBatch 单次eth_getLogs的目标地址数量
+const rpcTopicBatch = 200
+
+// GetDepositTransfers 目标地址放在topic2的或条件里分批查询
+func (r *RpcDepositSource) GetDepositTransfers(ctx context.Context, contract string, to []string, fromBlock int64, toBlock int64) ([]*biz.DepositTransfer, error) {
+	client, err := ethclient.DialContext(ctx, r.chain.RpcUrl)
+	if err != nil {
+		return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	defer client.Close()
+
+	logs := make([]types.Log, 0)
+	for i := 0; i < len(to); i += rpcTopicBatch {
+		j := i + rpcTopicBatch
+		if j > len(to) {
+			j = len(to)
+		}
+		toTopics := make([]common.Hash, 0, j-i)
+		for _, vTo := range to[i:j] {
+			toTopics = append(toTopics, common.BytesToHash(common.HexToAddress(vTo).Bytes()))
+		}
+
+		tmpLogs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: big.NewInt(fromBlock),
+			ToBlock:   big.NewInt(toBlock),
+			Addresses: []common.Address{common.HexToAddress(contract)},
+			Topics: [][]common.Hash{
+				{transferTopic},
+				nil,
+				toTopics,
+			},
+		})
+		if err != nil {
+			return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+		}
+		logs = append(logs, tmpLogs...)
+	}
+
+	res := make([]*biz.DepositTransfer, 0)
+	for _, v := range logs {
+		if transfer := rpcLogToTransfer(v); nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// GetDepositTransfersByHash 交易回执中的全部代币转账，交易未成功时为空
+func (r *RpcDepositSource) GetDepositTransfersByHash(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	client, err := ethclient.DialContext(ctx, r.chain.RpcUrl)
+	if err != nil {
+		return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	defer client.Close()
+
+	res := make([]*biz.DepositTransfer, 0)
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(hash))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return res, nil
+		}
+		return nil, errors.New(500, "DEPOSIT_SOURCE_ERROR", err.Error())
+	}
+	if types.ReceiptStatusSuccessful != receipt.Status {
+		return res, nil
+	}
+
+	for _, v := range receipt.Logs {
+		if transfer := rpcLogToTransfer(*v); nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// rpcLogToTransfer 不是Transfer事件时返回nil
+func rpcLogToTransfer(v types.Log) *biz.DepositTransfer {
+	if 3 != len(v.Topics) || transferTopic != v.Topics[0] || v.Removed {
+		return nil
+	}
+	return &biz.DepositTransfer{
+		Hash:        strings.ToLower(v.TxHash.Hex()),
+		From:        strings.ToLower(common.BytesToAddress(v.Topics[1].Bytes()).Hex()),
+		To:          strings.ToLower(common.BytesToAddress(v.Topics[2].Bytes()).Hex()),
+		Value:       new(big.Int).SetBytes(v.Data).String(),
+		Contract:    strings.ToLower(v.Address.Hex()),
+		BlockNumber: int64(v.BlockNumber),
+		BlockHash:   v.BlockHash.Hex(),
+		LogIndex:    int64(v.Index),
+	}
+}
+
+// FakeDepositSource 内存中的转入记录，本地联调和测试时代替链上数据
+type FakeDepositSource struct {
+	lock        sync.Mutex
+	blockNumber int64
+	transfers   []*biz.DepositTransfer
+}
+
+func NewFakeDepositSource() *FakeDepositSource {
+	return &FakeDepositSource{transfers: make([]*biz.DepositTransfer, 0)}
+}
+
+// Push 追加转入记录，最新区块高度随之推进
+func (f *FakeDepositSource) Push(transfers ...*biz.DepositTransfer) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, v := range transfers {
+		if v.BlockNumber > f.blockNumber {
+			f.blockNumber = v.BlockNumber
+		}
+	}
+	f.transfers = append(f.transfers, transfers...)
+}
+
+// SetLatestBlockNumber 模拟出块
+func (f *FakeDepositSource) SetLatestBlockNumber(blockNumber int64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.blockNumber = blockNumber
+}
+
+// GetLatestBlockNumber .
+func (f *FakeDepositSource) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.blockNumber, nil
+}
+
+// GetDepositTransfers .
+func (f *FakeDepositSource) GetDepositTransfers(ctx context.Context, contract string, to []string, fromBlock int64, toBlock int64) ([]*biz.DepositTransfer, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	toMap := make(map[string]bool, 0)
+	for _, v := range to {
+		toMap[strings.ToLower(v)] = true
+	}
+
+	res := make([]*biz.DepositTransfer, 0)
+	for _, v := range f.transfers {
+		if fromBlock <= v.BlockNumber && toBlock >= v.BlockNumber && strings.EqualFold(contract, v.Contract) && toMap[strings.ToLower(v.To)] {
+			res = append(res, v)
+		}
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// GetDepositTransfersByHash .
+func (f *FakeDepositSource) GetDepositTransfersByHash(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	res := make([]*biz.DepositTransfer, 0)
+	for _, v := range f.transfers {
+		if strings.EqualFold(hash, v.Hash) {
+			res = append(res, v)
+		}
+	}
+
+	sortDepositTransfers(res)
+	return res, nil
+}
+
+// sortDepositTransfers 按区块和log顺序排列
+func sortDepositTransfers(transfers []*biz.DepositTransfer) {
+	sort.SliceStable(transfers, func(i, j int) bool {
+		if transfers[i].BlockNumber != transfers[j].BlockNumber {
+			return transfers[i].BlockNumber < transfers[j].BlockNumber
+		}
+		return transfers[i].LogIndex < transfers[j].LogIndex
+	})
+}
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tyler-smith/go-bip32"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type DepositAddress struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null;uniqueIndex"`
+	Address   string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	PathIndex int64     `gorm:"type:int;not null"`
+	ProductId int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositAddressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositAddressRepo(data *Data, logger log.Logger) biz.DepositAddressRepo {
+	return &DepositAddressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateDepositAddress .
+func (d *DepositAddressRepo) CreateDepositAddress(ctx context.Context, bd *biz.DepositAddress) (*biz.DepositAddress, error) {
+	var depositAddress DepositAddress
+	depositAddress.UserId = bd.UserId
+	depositAddress.Address = strings.ToLower(bd.Address)
+	depositAddress.PathIndex = bd.PathIndex
+	if err := d.data.DB(ctx).Table("deposit_address").Create(&depositAddress).Error; err != nil {
+		return nil, errors.New(500, "CREATE_DEPOSIT_ADDRESS_ERROR", "充值地址创建失败")
+	}
+
+	return depositAddressToBiz(&depositAddress), nil
+}
+
+// GetDepositAddressByUserId .
+func (d *DepositAddressRepo) GetDepositAddressByUserId(ctx context.Context, userId int64) (*biz.DepositAddress, error) {
+	var depositAddress DepositAddress
+	if err := d.data.DB(ctx).Table("deposit_address").Where("user_id=?", userId).First(&depositAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("DEPOSIT_ADDRESS_NOT_FOUND", "deposit address not found")
+		}
+
+		return nil, errors.New(500, "DEPOSIT ADDRESS ERROR", err.Error())
+	}
+
+	return depositAddressToBiz(&depositAddress), nil
+}
+
+// GetDepositAddressByAddress .
+func (d *DepositAddressRepo) GetDepositAddressByAddress(ctx context.Context, address string) (*biz.DepositAddress, error) {
+	var depositAddress DepositAddress
+	if err := d.data.DB(ctx).Table("deposit_address").Where("address=?", strings.ToLower(address)).First(&depositAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("DEPOSIT_ADDRESS_NOT_FOUND", "deposit address not found")
+		}
+
+		return nil, errors.New(500, "DEPOSIT ADDRESS ERROR", err.Error())
+	}
+
+	return depositAddressToBiz(&depositAddress), nil
+}
+
+// UpdateDepositAddressProduct .
+func (d *DepositAddressRepo) UpdateDepositAddressProduct(ctx context.Context, userId int64, productId int64) error {
+	res := d.data.DB(ctx).Table("deposit_address").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"product_id": productId, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_DEPOSIT_ADDRESS_ERROR", "充值地址修改失败")
+	}
+
+	return nil
+}
+
+// GetDepositAddresses .
+func (d *DepositAddressRepo) GetDepositAddresses(ctx context.Context) (map[string]*biz.DepositAddress, error) {
+	var depositAddresses []*DepositAddress
+	if err := d.data.DB(ctx).Table("deposit_address").Find(&depositAddresses).Error; err != nil {
+		return nil, errors.New(500, "DEPOSIT ADDRESS ERROR", err.Error())
+	}
+
+	res := make(map[string]*biz.DepositAddress, 0)
+	for _, v := range depositAddresses {
+		res[strings.ToLower(v.Address)] = depositAddressToBiz(v)
+	}
+
+	return res, nil
+}
+
+func depositAddressToBiz(d *DepositAddress) *biz.DepositAddress {
+	return &biz.DepositAddress{
+		ID:        d.ID,
+		UserId:    d.UserId,
+		Address:   d.Address,
+		PathIndex: d.PathIndex,
+		ProductId: d.ProductId,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// HDAddressDeriver 由m/44'/60'/0'/0的扩展公钥派生子地址
+type HDAddressDeriver struct {
+	key *bip32.Key
+}
+
+// NewAddressDeriver 未配置xpub时服务照常启动，派生时报错
+func NewAddressDeriver(c *conf.Deposit) (biz.AddressDeriver, error) {
+	if "" == c.HdXpub {
+		return &HDAddressDeriver{}, nil
+	}
+
+	key, err := bip32.B58Deserialize(c.HdXpub)
+	if nil != err {
+		return nil, err
+	}
+	if key.IsPrivate { // 服务器上不放私钥
+		return nil, errors.New(500, "DEPOSIT_ADDRESS_ERROR", "hd_xpub必须是扩展公钥")
+	}
+
+	return &HDAddressDeriver{key: key}, nil
+}
+
+func (h *HDAddressDeriver) DeriveAddress(index int64) (string, error) {
+	if nil == h.key {
+		return "", errors.New(500, "DEPOSIT_ADDRESS_ERROR", "未配置hd_xpub")
+	}
+	if 0 > index || bip32.FirstHardenedChild <= uint32(index) || index != int64(uint32(index)) {
+		return "", errors.New(500, "DEPOSIT_ADDRESS_ERROR", "派生索引错误")
+	}
+
+	child, err := h.key.NewChildKey(uint32(index))
+	if nil != err {
+		return "", errors.New(500, "DEPOSIT_ADDRESS_ERROR", err.Error())
+	}
+	pub, err := crypto.DecompressPubkey(child.Key)
+	if nil != err {
+		return "", errors.New(500, "DEPOSIT_ADDRESS_ERROR", err.Error())
+	}
+
+	return strings.ToLower(crypto.PubkeyToAddress(*pub).Hex()), nil
+}
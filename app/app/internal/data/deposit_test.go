@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeBscscan 每个区块logsPerBlock条Transfer，转入地址在addresses中轮换，按topic2过滤
+type fakeBscscan struct {
+	logsPerBlock int
+	addresses    []string
+	requests     int
+}
+
+func (f *fakeBscscan) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests++
+	q := r.URL.Query()
+	if "" == q.Get("topic2") || "and" != q.Get("topic0_2_opr") {
+		http.Error(w, "missing topic2", http.StatusBadRequest)
+		return
+	}
+	fromBlock, _ := strconv.Atoi(q.Get("fromBlock"))
+	toBlock, _ := strconv.Atoi(q.Get("toBlock"))
+	page, _ := strconv.Atoi(q.Get("page"))
+	offset, _ := strconv.Atoi(q.Get("offset"))
+
+	if 10000 < page*offset {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "0", "message": "NOTOK", "result": "Result window is too large"})
+		return
+	}
+
+	logs := make([]*bscscanLog, 0)
+	for block := fromBlock; block <= toBlock; block++ {
+		for i := 0; i < f.logsPerBlock; i++ {
+			to := f.addresses[(block*f.logsPerBlock+i)%len(f.addresses)]
+			topic2 := common.BytesToHash(common.HexToAddress(to).Bytes()).Hex()
+			if !strings.EqualFold(topic2, q.Get("topic2")) {
+				continue
+			}
+			logs = append(logs, &bscscanLog{
+				Address:         q.Get("address"),
+				Topics:          []string{transferTopic.Hex(), common.BytesToHash(common.HexToAddress("0x01").Bytes()).Hex(), topic2},
+				Data:            "0x01",
+				BlockNumber:     fmt.Sprintf("0x%x", block),
+				LogIndex:        fmt.Sprintf("0x%x", i),
+				TransactionHash: fmt.Sprintf("0x%064x", block*f.logsPerBlock+i),
+			})
+		}
+	}
+
+	start := (page - 1) * offset
+	if start > len(logs) {
+		start = len(logs)
+	}
+	end := start + offset
+	if end > len(logs) {
+		end = len(logs)
+	}
+	if start == end {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "0", "message": "No records found", "result": []interface{}{}})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "1", "message": "OK", "result": logs[start:end]})
+}
+
+func newTestBscscanSource(t *testing.T, f *fakeBscscan) *BscscanDepositSource {
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return &BscscanDepositSource{conf: &conf.Deposit{Bscscan: &conf.Deposit_Bscscan{Url: srv.URL}}, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func TestBscscanDepositTransfersByRecipient(t *testing.T) {
+	f := &fakeBscscan{logsPerBlock: 4, addresses: []string{
+		"0x1111111111111111111111111111111111111111",
+		"0x2222222222222222222222222222222222222222",
+		"0x3333333333333333333333333333333333333333",
+		"0x4444444444444444444444444444444444444444",
+	}}
+	b := newTestBscscanSource(t, f)
+
+	// 接口按topic2过滤，每个收款地址一次请求，没有转入的地址返回空
+	to := []string{"0x1111111111111111111111111111111111111111", "0x3333333333333333333333333333333333333333"}
+	for i := 0; i < 3; i++ {
+		to = append(to, common.BigToAddress(new(big.Int).Lsh(big.NewInt(1), uint(i+8))).Hex())
+	}
+	transfers, err := b.GetDepositTransfers(context.Background(), "0x55d398326f99059ff775485246999027b3197955", to, 10, 19)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(to) != f.requests {
+		t.Fatalf("requests %d, want %d", f.requests, len(to))
+	}
+	if 20 != len(transfers) {
+		t.Fatalf("transfers %d, want 20", len(transfers))
+	}
+	for _, v := range transfers {
+		if "0x1111111111111111111111111111111111111111" != v.To && "0x3333333333333333333333333333333333333333" != v.To {
+			t.Fatalf("unexpected to %s", v.To)
+		}
+	}
+}
+
+func TestBscscanDepositTransfersRateLimit(t *testing.T) {
+	f := &fakeBscscan{logsPerBlock: 1, addresses: []string{"0x1111111111111111111111111111111111111111"}}
+	b := newTestBscscanSource(t, f)
+	b.conf.Bscscan.RateLimit = 20
+
+	to := make([]string, 0)
+	for i := 0; i < 5; i++ {
+		to = append(to, common.BigToAddress(big.NewInt(int64(i+1))).Hex())
+	}
+	start := time.Now()
+	if _, err := b.GetDepositTransfers(context.Background(), "0x55d398326f99059ff775485246999027b3197955", to, 1, 1); nil != err {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); 200*time.Millisecond > d {
+		t.Fatalf("5 requests in %s, want at least 200ms at 20/s", d)
+	}
+}
+
+func TestBscscanDepositTransfersSplitRange(t *testing.T) {
+	f := &fakeBscscan{logsPerBlock: 1500, addresses: []string{"0x1111111111111111111111111111111111111111"}}
+	b := newTestBscscanSource(t, f)
+
+	transfers, err := b.GetDepositTransfers(context.Background(), "0x55d398326f99059ff775485246999027b3197955", []string{"0x1111111111111111111111111111111111111111"}, 1, 8)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 12000 != len(transfers) {
+		t.Fatalf("transfers %d, want 12000", len(transfers))
+	}
+	seen := make(map[string]bool, 0)
+	for i, v := range transfers {
+		key := v.Hash + strconv.FormatInt(v.LogIndex, 10)
+		if seen[key] {
+			t.Fatalf("duplicate transfer %s", key)
+		}
+		seen[key] = true
+		if 0 < i && transfers[i-1].BlockNumber > v.BlockNumber {
+			t.Fatal("transfers not sorted")
+		}
+		if !strings.HasPrefix(v.Hash, "0x") {
+			t.Fatalf("hash %s", v.Hash)
+		}
+	}
+
+	// 单个区块超过上限时报错，提示改用rpc
+	f = &fakeBscscan{logsPerBlock: 12000, addresses: []string{"0x1111111111111111111111111111111111111111"}}
+	b = newTestBscscanSource(t, f)
+	if _, err = b.GetDepositTransfers(context.Background(), "0x55d398326f99059ff775485246999027b3197955", []string{"0x1111111111111111111111111111111111111111"}, 1, 1); nil == err {
+		t.Fatal("want error for a block over the result window")
+	}
+}
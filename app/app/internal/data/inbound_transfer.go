@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type InboundTransfer struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Hash        string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_hash_log"`
+	LogIndex    int64     `gorm:"type:int;not null;uniqueIndex:idx_hash_log"`
+	FromAddress string    `gorm:"type:varchar(100);not null"`
+	ToAddress   string    `gorm:"type:varchar(100);not null"`
+	CoinType    string    `gorm:"type:varchar(45);not null"`
+	Value       string    `gorm:"type:varchar(100);not null"`
+	Amount      int64     `gorm:"type:bigint;not null"`
+	UserId      int64     `gorm:"type:int;not null"`
+	BlockNumber int64     `gorm:"type:bigint;not null"`
+	BlockHash   string    `gorm:"type:varchar(100);not null"`
+	ProductId   int64     `gorm:"type:int;not null"`
+	Disposition string    `gorm:"type:varchar(45);not null"`
+	Resolution  string    `gorm:"type:varchar(45);not null"`
+	Remark      string    `gorm:"type:varchar(255);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type InboundTransferRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewInboundTransferRepo(data *Data, logger log.Logger) biz.InboundTransferRepo {
+	return &InboundTransferRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateInboundTransfer .
+func (i *InboundTransferRepo) CreateInboundTransfer(ctx context.Context, bt *biz.InboundTransfer) (*biz.InboundTransfer, error) {
+	var inboundTransfer InboundTransfer
+	inboundTransfer.Hash = bt.Hash
+	inboundTransfer.LogIndex = bt.LogIndex
+	inboundTransfer.FromAddress = bt.FromAddress
+	inboundTransfer.ToAddress = bt.ToAddress
+	inboundTransfer.CoinType = bt.CoinType
+	inboundTransfer.Value = bt.Value
+	inboundTransfer.Amount = bt.Amount
+	inboundTransfer.UserId = bt.UserId
+	inboundTransfer.BlockNumber = bt.BlockNumber
+	inboundTransfer.BlockHash = bt.BlockHash
+	inboundTransfer.ProductId = bt.ProductId
+	inboundTransfer.Disposition = bt.Disposition
+	inboundTransfer.Remark = bt.Remark
+	if err := i.data.DB(ctx).Table("inbound_transfer").Create(&inboundTransfer).Error; err != nil {
+		return nil, errors.New(500, "CREATE_INBOUND_TRANSFER_ERROR", "转入记录创建失败")
+	}
+
+	return inboundTransferToBiz(&inboundTransfer), nil
+}
+
+// GetInboundTransfersByHash .
+func (i *InboundTransferRepo) GetInboundTransfersByHash(ctx context.Context, hash ...string) ([]*biz.InboundTransfer, error) {
+	var inboundTransfers []*InboundTransfer
+	if err := i.data.DB(ctx).Table("inbound_transfer").Where("hash IN (?)", hash).Order("log_index asc").Find(&inboundTransfers).Error; err != nil {
+		return nil, errors.New(500, "INBOUND TRANSFER ERROR", err.Error())
+	}
+
+	res := make([]*biz.InboundTransfer, 0)
+	for _, v := range inboundTransfers {
+		res = append(res, inboundTransferToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetInboundTransferById .
+func (i *InboundTransferRepo) GetInboundTransferById(ctx context.Context, id int64) (*biz.InboundTransfer, error) {
+	var inboundTransfer InboundTransfer
+	if err := i.data.DB(ctx).Table("inbound_transfer").Where("id=?", id).First(&inboundTransfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("INBOUND_TRANSFER_NOT_FOUND", "inbound transfer not found")
+		}
+
+		return nil, errors.New(500, "INBOUND TRANSFER ERROR", err.Error())
+	}
+
+	return inboundTransferToBiz(&inboundTransfer), nil
+}
+
+// GetInboundTransfers .
+func (i *InboundTransferRepo) GetInboundTransfers(ctx context.Context, b *biz.Pagination, disposition string, pending int64) ([]*biz.InboundTransfer, error, int64) {
+	var (
+		inboundTransfers []*InboundTransfer
+		count            int64
+	)
+	res := make([]*biz.InboundTransfer, 0)
+
+	instance := i.data.DB(ctx).Table("inbound_transfer")
+	if "" != disposition {
+		instance = instance.Where("disposition=?", disposition)
+	}
+	if 1 == pending {
+		instance = instance.Where("disposition not in(?) and resolution=?", []string{biz.InboundCredited, biz.InboundPendingPair}, "")
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&inboundTransfers).Error; err != nil {
+		return nil, errors.New(500, "INBOUND TRANSFER ERROR", err.Error()), 0
+	}
+
+	for _, v := range inboundTransfers {
+		res = append(res, inboundTransferToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// UpdateInboundTransferDisposition .
+func (i *InboundTransferRepo) UpdateInboundTransferDisposition(ctx context.Context, hash string, logIndex int64, from string, to string) error {
+	res := i.data.DB(ctx).Table("inbound_transfer").Where("hash=? and log_index=? and disposition=?", hash, logIndex, from).
+		Updates(map[string]interface{}{"disposition": to, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_INBOUND_TRANSFER_ERROR", "转入记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateInboundTransferResolution .
+func (i *InboundTransferRepo) UpdateInboundTransferResolution(ctx context.Context, id int64, userId int64, resolution string, remark string) error {
+	res := i.data.DB(ctx).Table("inbound_transfer").Where("id=? and resolution=?", id, "").
+		Updates(map[string]interface{}{"user_id": userId, "resolution": resolution, "remark": remark, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_INBOUND_TRANSFER_ERROR", "转入记录修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_INBOUND_TRANSFER_ERROR", "转入记录已处理")
+	}
+
+	return nil
+}
+
+func inboundTransferToBiz(t *InboundTransfer) *biz.InboundTransfer {
+	return &biz.InboundTransfer{
+		ID:          t.ID,
+		Hash:        t.Hash,
+		LogIndex:    t.LogIndex,
+		FromAddress: t.FromAddress,
+		ToAddress:   t.ToAddress,
+		CoinType:    t.CoinType,
+		Value:       t.Value,
+		Amount:      t.Amount,
+		UserId:      t.UserId,
+		BlockNumber: t.BlockNumber,
+		BlockHash:   t.BlockHash,
+		ProductId:   t.ProductId,
+		Disposition: t.Disposition,
+		Resolution:  t.Resolution,
+		Remark:      t.Remark,
+		CreatedAt:   t.CreatedAt,
+	}
+}
@@ -32,6 +32,7 @@ type LocationNew struct {
 	OutRate           int64     `gorm:"type:int;not null"`
 	StopCoin          int64     `gorm:"type:bigint;not null"`
 	StopDate          time.Time `gorm:"type:datetime;not null"`
+	ProductId         int64     `gorm:"type:int;not null"`
 	CreatedAt         time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt         time.Time `gorm:"type:datetime;not null"`
 }
@@ -89,6 +90,7 @@ func (lr *LocationRepo) CreateLocationNew(ctx context.Context, rel *biz.Location
 	location.UserId = rel.UserId
 	location.OutRate = rel.OutRate
 	location.StopDate = rel.StopDate
+	location.ProductId = rel.ProductId
 	res := lr.data.DB(ctx).Table("location_new").Create(&location)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "占位信息创建失败")
@@ -100,6 +102,7 @@ func (lr *LocationRepo) CreateLocationNew(ctx context.Context, rel *biz.Location
 		Status:     location.Status,
 		Current:    location.Current,
 		CurrentMax: location.CurrentMax,
+		ProductId:  location.ProductId,
 	}, nil
 }
 
@@ -379,12 +382,39 @@ func (lr *LocationRepo) GetLocationsNewByUserId(ctx context.Context, userId int6
 			Current:    location.Current,
 			CurrentMax: location.CurrentMax,
 			OutRate:    location.OutRate,
+			ProductId:  location.ProductId,
 		})
 	}
 
 	return res, nil
 }
 
+// GetLocationNewById .
+func (lr *LocationRepo) GetLocationNewById(ctx context.Context, id int64) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := lr.data.DB(ctx).Table("location_new").Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.LocationNew{
+		ID:                location.ID,
+		UserId:            location.UserId,
+		Status:            location.Status,
+		Current:           location.Current,
+		CurrentMax:        location.CurrentMax,
+		StopLocationAgain: location.StopLocationAgain,
+		OutRate:           location.OutRate,
+		StopCoin:          location.StopCoin,
+		StopDate:          location.StopDate,
+		ProductId:         location.ProductId,
+		CreatedAt:         location.CreatedAt,
+	}, nil
+}
+
 // GetLocationsStopNotUpdate .
 func (lr *LocationRepo) GetLocationsStopNotUpdate(ctx context.Context) ([]*biz.Location, error) {
 	var locations []*Location
@@ -530,6 +560,22 @@ func (lr *LocationRepo) UpdateLocationNew(ctx context.Context, id int64, status
 	return nil
 }
 
+// TopUpLocationNew 运行中的仓位追加出局额度
+func (lr *LocationRepo) TopUpLocationNew(ctx context.Context, id int64, currentMax int64) error {
+	res := lr.data.DB(ctx).Table("location_new").
+		Where("id=?", id).
+		Where("status=?", "running").
+		Updates(map[string]interface{}{"current_max": gorm.Expr("current_max + ?", currentMax)})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位追加失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位已不在运行中")
+	}
+
+	return nil
+}
+
 // GetRunningLocations .
 func (lr *LocationRepo) GetRunningLocations(ctx context.Context) ([]*biz.LocationNew, error) {
 	var locations []*LocationNew
@@ -553,6 +599,7 @@ func (lr *LocationRepo) GetRunningLocations(ctx context.Context) ([]*biz.Locatio
 			CurrentMax: location.CurrentMax,
 			CreatedAt:  location.CreatedAt,
 			OutRate:    location.OutRate,
+			ProductId:  location.ProductId,
 		})
 	}
 
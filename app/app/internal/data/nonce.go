@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+// 地址一致才删除，保证nonce只能使用一次
+var useNonceScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+type NonceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNonceRepo(data *Data, logger log.Logger) biz.NonceRepo {
+	return &NonceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateNonce .
+func (n *NonceRepo) CreateNonce(ctx context.Context, nonce string, address string, ttl time.Duration) error {
+	ok, err := n.data.rdb.SetNX(ctx, "siwe:nonce:"+nonce, address, ttl).Result()
+	if nil != err {
+		return errors.New(500, "CREATE_NONCE_ERROR", err.Error())
+	}
+	if !ok {
+		return errors.New(500, "CREATE_NONCE_ERROR", "nonce已存在")
+	}
+
+	return nil
+}
+
+// GetNonce 返回nonce对应的地址，不会使nonce失效
+func (n *NonceRepo) GetNonce(ctx context.Context, nonce string) (string, error) {
+	address, err := n.data.rdb.Get(ctx, "siwe:nonce:"+nonce).Result()
+	if nil != err {
+		if errors.Is(err, redis.Nil) {
+			return "", errors.NotFound("NONCE_NOT_FOUND", "nonce not found")
+		}
+
+		return "", errors.New(500, "NONCE ERROR", err.Error())
+	}
+
+	return address, nil
+}
+
+// UseNonce nonce属于该地址时删除，返回false表示已被使用或已过期
+func (n *NonceRepo) UseNonce(ctx context.Context, nonce string, address string) (bool, error) {
+	deleted, err := useNonceScript.Run(ctx, n.data.rdb, []string{"siwe:nonce:" + nonce}, address).Int64()
+	if nil != err {
+		return false, errors.New(500, "NONCE ERROR", err.Error())
+	}
+
+	return 1 == deleted, nil
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"testing"
+	"time"
+)
+
+func TestUseNonceOnlyByOwner(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	n := &NonceRepo{data: &Data{rdb: rdb}, log: log.NewHelper(log.DefaultLogger)}
+	ctx := context.Background()
+
+	if err := n.CreateNonce(ctx, "n1", "0xa1", time.Minute); nil != err {
+		t.Fatal(err)
+	}
+	if address, err := n.GetNonce(ctx, "n1"); nil != err || "0xa1" != address {
+		t.Fatalf("get nonce %s %v", address, err)
+	}
+	// 查询不会使nonce失效，地址不一致不删除
+	if used, err := n.UseNonce(ctx, "n1", "0xb2"); nil != err || used {
+		t.Fatalf("use by other address %v %v", used, err)
+	}
+	if used, err := n.UseNonce(ctx, "n1", "0xa1"); nil != err || !used {
+		t.Fatalf("use %v %v", used, err)
+	}
+	if used, err := n.UseNonce(ctx, "n1", "0xa1"); nil != err || used {
+		t.Fatalf("reuse %v %v", used, err)
+	}
+	if _, err := n.GetNonce(ctx, "n1"); nil == err {
+		t.Fatal("nonce still exists")
+	}
+}
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Product struct {
+	ID                 int64     `gorm:"primarykey;type:int"`
+	Name               string    `gorm:"type:varchar(100);not null"`
+	MinAmount          int64     `gorm:"type:bigint;not null"`
+	MaxAmount          int64     `gorm:"type:bigint;not null"`
+	OutRate            int64     `gorm:"type:int;not null"`
+	LocationRewardRate int64     `gorm:"type:int;not null"`
+	RewardRate         int64     `gorm:"type:int;not null"`
+	CoinRewardRate     int64     `gorm:"type:int;not null"`
+	StartTime          time.Time `gorm:"type:datetime"`
+	EndTime            time.Time `gorm:"type:datetime"`
+	Status             int64     `gorm:"type:int;not null"`
+	CreatedAt          time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt          time.Time `gorm:"type:datetime;not null"`
+}
+
+type ProductRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewProductRepo(data *Data, logger log.Logger) biz.ProductRepo {
+	return &ProductRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetProducts .
+func (p *ProductRepo) GetProducts(ctx context.Context) ([]*biz.Product, error) {
+	var products []*Product
+	res := make([]*biz.Product, 0)
+	if err := p.data.DB(ctx).Table("product").Order("id asc").Find(&products).Error; err != nil {
+		return nil, errors.New(500, "PRODUCT ERROR", err.Error())
+	}
+
+	for _, v := range products {
+		res = append(res, productToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetProductById .
+func (p *ProductRepo) GetProductById(ctx context.Context, id int64) (*biz.Product, error) {
+	var product Product
+	if err := p.data.DB(ctx).Table("product").Where("id=?", id).First(&product).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("PRODUCT_NOT_FOUND", "product not found")
+		}
+
+		return nil, errors.New(500, "PRODUCT ERROR", err.Error())
+	}
+
+	return productToBiz(&product), nil
+}
+
+// CreateProduct .
+func (p *ProductRepo) CreateProduct(ctx context.Context, bp *biz.Product) (*biz.Product, error) {
+	var product Product
+	product.Name = bp.Name
+	product.MinAmount = bp.MinAmount
+	product.MaxAmount = bp.MaxAmount
+	product.OutRate = bp.OutRate
+	product.LocationRewardRate = bp.LocationRewardRate
+	product.RewardRate = bp.RewardRate
+	product.CoinRewardRate = bp.CoinRewardRate
+	product.StartTime = bp.StartTime
+	product.EndTime = bp.EndTime
+	product.Status = bp.Status
+	if err := p.data.DB(ctx).Table("product").Create(&product).Error; err != nil {
+		return nil, errors.New(500, "CREATE_PRODUCT_ERROR", "套餐创建失败")
+	}
+
+	return productToBiz(&product), nil
+}
+
+// UpdateProduct .
+func (p *ProductRepo) UpdateProduct(ctx context.Context, bp *biz.Product) error {
+	res := p.data.DB(ctx).Table("product").Where("id=?", bp.ID).
+		Updates(map[string]interface{}{
+			"name":                 bp.Name,
+			"min_amount":           bp.MinAmount,
+			"max_amount":           bp.MaxAmount,
+			"out_rate":             bp.OutRate,
+			"location_reward_rate": bp.LocationRewardRate,
+			"reward_rate":          bp.RewardRate,
+			"coin_reward_rate":     bp.CoinRewardRate,
+			"start_time":           bp.StartTime,
+			"end_time":             bp.EndTime,
+			"status":               bp.Status,
+			"updated_at":           time.Now(),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_PRODUCT_ERROR", "套餐修改失败")
+	}
+
+	return nil
+}
+
+func productToBiz(p *Product) *biz.Product {
+	return &biz.Product{
+		ID:                 p.ID,
+		Name:               p.Name,
+		MinAmount:          p.MinAmount,
+		MaxAmount:          p.MaxAmount,
+		OutRate:            p.OutRate,
+		LocationRewardRate: p.LocationRewardRate,
+		RewardRate:         p.RewardRate,
+		CoinRewardRate:     p.CoinRewardRate,
+		StartTime:          p.StartTime,
+		EndTime:            p.EndTime,
+		Status:             p.Status,
+		CreatedAt:          p.CreatedAt,
+	}
+}
@@ -10,15 +10,22 @@ import (
 )
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+	ID              int64     `gorm:"primarykey;type:int"`
+	Hash            string    `gorm:"type:varchar(100);not null"`
+	LogIndex        int64     `gorm:"type:int;not null"`
+	UserId          int64     `gorm:"type:int;not null"`
+	Status          string    `gorm:"type:varchar(45);not null"`
+	Type            string    `gorm:"type:varchar(45);not null"`
+	Amount          string    `gorm:"type:varchar(45);not null"`
+	CoinType        string    `gorm:"type:varchar(45);not null"`
+	BlockNumber     int64     `gorm:"type:bigint;not null"`
+	BlockHash       string    `gorm:"type:varchar(100);not null"`
+	Reorg           int64     `gorm:"type:int;not null"`
+	LocationId      int64     `gorm:"type:int;not null"`
+	BalanceRecordId int64     `gorm:"type:int;not null"`
+	ProductId       int64     `gorm:"type:int;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecordRepo struct {
@@ -45,15 +52,7 @@ func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash
 
 	res := make(map[string]*biz.EthUserRecord, 0)
 	for _, item := range ethUserRecord {
-		res[item.Hash] = &biz.EthUserRecord{
-			ID:       item.ID,
-			UserId:   item.UserId,
-			Hash:     item.Hash,
-			Status:   item.Status,
-			Type:     item.Type,
-			Amount:   item.Amount,
-			CoinType: item.CoinType,
-		}
+		res[item.Hash] = ethUserRecordToBiz(item)
 	}
 
 	return res, nil
@@ -63,23 +62,161 @@ func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r
 	var ethUserRecord EthUserRecord
 	ethUserRecord.UserId = r.UserId
 	ethUserRecord.Hash = r.Hash
+	ethUserRecord.LogIndex = r.LogIndex
 	ethUserRecord.Type = r.Type
 	ethUserRecord.Status = r.Status
 	ethUserRecord.Amount = r.Amount
 	ethUserRecord.CoinType = r.CoinType
+	ethUserRecord.BlockNumber = r.BlockNumber
+	ethUserRecord.BlockHash = r.BlockHash
+	ethUserRecord.LocationId = r.LocationId
+	ethUserRecord.BalanceRecordId = r.BalanceRecordId
+	ethUserRecord.ProductId = r.ProductId
 
 	res := e.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_ETH_USER_RECORD_ERROR", "以太坊交易信息创建失败")
 	}
 
+	return ethUserRecordToBiz(&ethUserRecord), nil
+}
+
+// GetEthUserRecordsByHash .
+func (e *EthUserRecordRepo) GetEthUserRecordsByHash(ctx context.Context, hash ...string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := e.data.DB(ctx).Table("eth_user_record").Where("hash IN (?)", hash).
+		Order("id asc").Find(&ethUserRecords).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, ethUserRecordToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetEthUserRecordsFromBlock .
+func (e *EthUserRecordRepo) GetEthUserRecordsFromBlock(ctx context.Context, fromBlock int64) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := e.data.DB(ctx).Table("eth_user_record").
+		Where("block_number>=? and reorg=? and block_hash<>?", fromBlock, 0, "").
+		Order("block_number asc").Find(&ethUserRecords).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, ethUserRecordToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetEthUserRecordsByReorg .
+func (e *EthUserRecordRepo) GetEthUserRecordsByReorg(ctx context.Context, b *biz.Pagination, reorg int64) ([]*biz.EthUserRecord, error, int64) {
+	var (
+		ethUserRecords []*EthUserRecord
+		count          int64
+	)
+	res := make([]*biz.EthUserRecord, 0)
+
+	instance := e.data.DB(ctx).Table("eth_user_record").Where("reorg=?", reorg)
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&ethUserRecords).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error()), 0
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, ethUserRecordToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// UpdateEthUserRecordBlock .
+func (e *EthUserRecordRepo) UpdateEthUserRecordBlock(ctx context.Context, id int64, blockNumber int64, blockHash string) error {
+	res := e.data.DB(ctx).Table("eth_user_record").Where("id=?", id).
+		Updates(map[string]interface{}{"block_number": blockNumber, "block_hash": blockHash})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateEthUserRecordReorg .
+func (e *EthUserRecordRepo) UpdateEthUserRecordReorg(ctx context.Context, id int64, from int64, to int64) error {
+	res := e.data.DB(ctx).Table("eth_user_record").Where("id=? and reorg=?", id, from).
+		Updates(map[string]interface{}{"reorg": to})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值记录修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "回滚标记已变化")
+	}
+
+	return nil
+}
+
+// GetEthUserRecordsByStatus .
+func (e *EthUserRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, status string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := e.data.DB(ctx).Table("eth_user_record").Where("status=?", status).
+		Order("id asc").Find(&ethUserRecords).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, ethUserRecordToBiz(v))
+	}
+
+	return res, nil
+}
+
+// UpdateEthUserRecordLocation .
+func (e *EthUserRecordRepo) UpdateEthUserRecordLocation(ctx context.Context, id int64, recordType string, locationId int64, balanceRecordId int64) error {
+	res := e.data.DB(ctx).Table("eth_user_record").Where("id=?", id).
+		Updates(map[string]interface{}{"type": recordType, "location_id": locationId, "balance_record_id": balanceRecordId})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateEthUserRecordStatus .
+func (e *EthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, from string, to string) error {
+	res := e.data.DB(ctx).Table("eth_user_record").Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{"status": to})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值记录修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值状态已变化")
+	}
+
+	return nil
+}
+
+func ethUserRecordToBiz(r *EthUserRecord) *biz.EthUserRecord {
 	return &biz.EthUserRecord{
-		ID:       ethUserRecord.ID,
-		UserId:   ethUserRecord.UserId,
-		Hash:     ethUserRecord.Hash,
-		Status:   ethUserRecord.Status,
-		Type:     ethUserRecord.Type,
-		Amount:   ethUserRecord.Amount,
-		CoinType: ethUserRecord.CoinType,
-	}, nil
+		ID:              r.ID,
+		UserId:          r.UserId,
+		Hash:            r.Hash,
+		LogIndex:        r.LogIndex,
+		Status:          r.Status,
+		Type:            r.Type,
+		Amount:          r.Amount,
+		CoinType:        r.CoinType,
+		BlockNumber:     r.BlockNumber,
+		BlockHash:       r.BlockHash,
+		Reorg:           r.Reorg,
+		LocationId:      r.LocationId,
+		BalanceRecordId: r.BalanceRecordId,
+		ProductId:       r.ProductId,
+		CreatedAt:       r.CreatedAt,
+	}
 }
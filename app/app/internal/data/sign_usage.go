@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"math/big"
+	"strings"
+	"time"
+)
+
+type SignUsage struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Wallet      string    `gorm:"type:varchar(100);not null;uniqueIndex:wallet_nonce_token"`
+	Nonce       int64     `gorm:"type:bigint;not null;uniqueIndex:wallet_nonce_token"`
+	Token       string    `gorm:"type:varchar(100);not null;uniqueIndex:wallet_nonce_token"`
+	Destination string    `gorm:"type:varchar(100);not null"`
+	Amount      string    `gorm:"type:varchar(100);not null"`
+	Day         string    `gorm:"type:varchar(20);not null;index"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+// SignPolicyRepo 签名服务直接连库，不依赖redis
+type SignPolicyRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+func NewSignPolicyRepo(db *gorm.DB, logger log.Logger) biz.SignPolicyRepo {
+	return &SignPolicyRepo{
+		db:  db,
+		log: log.NewHelper(logger),
+	}
+}
+
+// GetSignUsage .
+func (s *SignPolicyRepo) GetSignUsage(ctx context.Context, wallet string, nonce int64, token string) (*biz.SignUsage, error) {
+	var signUsage SignUsage
+	if err := s.db.WithContext(ctx).Table("sign_usage").Where("wallet=? and nonce=? and token=?", wallet, nonce, token).First(&signUsage).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "SIGN USAGE ERROR", err.Error())
+	}
+
+	return signUsageToBiz(&signUsage)
+}
+
+// GetSignUsagesByDay .
+func (s *SignPolicyRepo) GetSignUsagesByDay(ctx context.Context, token string, day string) ([]*biz.SignUsage, error) {
+	var signUsages []*SignUsage
+	if err := s.db.WithContext(ctx).Table("sign_usage").Where("token=? and day=?", token, day).Find(&signUsages).Error; err != nil {
+		return nil, errors.New(500, "SIGN USAGE ERROR", err.Error())
+	}
+
+	res := make([]*biz.SignUsage, 0)
+	for _, item := range signUsages {
+		u, err := signUsageToBiz(item)
+		if nil != err {
+			return nil, err
+		}
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// SaveSignUsage .
+func (s *SignPolicyRepo) SaveSignUsage(ctx context.Context, u *biz.SignUsage) error {
+	if 0 == u.ID {
+		signUsage := &SignUsage{
+			Wallet:      u.Wallet,
+			Nonce:       u.Nonce,
+			Token:       u.Token,
+			Destination: u.Destination,
+			Amount:      u.Amount.String(),
+			Day:         u.Day,
+		}
+		if err := s.db.WithContext(ctx).Table("sign_usage").Create(signUsage).Error; err != nil {
+			return errors.New(500, "CREATE_SIGN_USAGE_ERROR", "出款记录创建失败")
+		}
+		u.ID = signUsage.ID
+		return nil
+	}
+
+	res := s.db.WithContext(ctx).Table("sign_usage").Where("id=?", u.ID).
+		Updates(map[string]interface{}{"destination": u.Destination, "amount": u.Amount.String()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_SIGN_USAGE_ERROR", "出款记录修改失败")
+	}
+
+	return nil
+}
+
+// IsUserAddress 用户地址历史上有小写和checksum两种写法
+func (s *SignPolicyRepo) IsUserAddress(ctx context.Context, address string) (bool, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Table("user").
+		Where("address in (?)", []string{strings.ToLower(address), common.HexToAddress(address).Hex()}).
+		Count(&count).Error; err != nil {
+		return false, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	return 0 < count, nil
+}
+
+func signUsageToBiz(s *SignUsage) (*biz.SignUsage, error) {
+	amount, ok := new(big.Int).SetString(s.Amount, 10)
+	if !ok {
+		return nil, errors.New(500, "SIGN USAGE ERROR", "出款金额错误")
+	}
+
+	return &biz.SignUsage{
+		ID:          s.ID,
+		Wallet:      s.Wallet,
+		Nonce:       s.Nonce,
+		Token:       s.Token,
+		Destination: s.Destination,
+		Amount:      amount,
+		Day:         s.Day,
+	}, nil
+}
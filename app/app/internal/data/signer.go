@@ -0,0 +1,324 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/tyler-smith/go-bip32"
+	grpc2 "google.golang.org/grpc"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// NewSigner 按配置创建出款签名
+func NewSigner(c *conf.Signer, logger log.Logger) (biz.Signer, func(), error) {
+	l := log.NewHelper(logger)
+	cleanup := func() {}
+
+	if nil == c {
+		c = &conf.Signer{}
+	}
+
+	switch c.Type {
+	case "remote":
+		if nil == c.Remote {
+			return nil, cleanup, errors.New(500, "SIGNER_ERROR", "签名服务未配置")
+		}
+		timeout := 10 * time.Second
+		if nil != c.Remote.Timeout && 0 < c.Remote.Timeout.AsDuration() {
+			timeout = c.Remote.Timeout.AsDuration()
+		}
+		signer, err := NewRemoteSigner(c.Remote.Addr, os.Getenv(c.Remote.TokenEnv), timeout)
+		if nil != err {
+			return nil, cleanup, err
+		}
+		l.Infof("remote signer %s", signer.Address().Hex())
+		return signer, func() {
+			if err := signer.Close(); nil != err {
+				l.Error(err)
+			}
+		}, nil
+	case "":
+		return nil, cleanup, errors.New(500, "SIGNER_ERROR", "签名类型未配置")
+	case "keystore":
+		if nil == c.Keystore {
+			return nil, cleanup, errors.New(500, "SIGNER_ERROR", "keystore未配置")
+		}
+		signer, err := NewKeystoreSigner(c.Keystore.Path, os.Getenv(c.Keystore.PassphraseEnv))
+		if nil != err {
+			return nil, cleanup, err
+		}
+		return signer, cleanup, nil
+	case "memory":
+		// 不生成临时私钥，避免未配置时从随机钱包出款
+		if nil == c.Memory || "" == c.Memory.PrivateKeyEnv || "" == os.Getenv(c.Memory.PrivateKeyEnv) {
+			return nil, cleanup, errors.New(500, "SIGNER_ERROR", "私钥未配置")
+		}
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(os.Getenv(c.Memory.PrivateKeyEnv), "0x"))
+		if nil != err {
+			return nil, cleanup, errors.New(500, "SIGNER_ERROR", err.Error())
+		}
+
+		signer := NewMemorySigner(privateKey)
+		l.Infof("memory signer %s", signer.Address().Hex())
+		return signer, cleanup, nil
+	default:
+		return nil, cleanup, errors.New(500, "SIGNER_ERROR", "不支持的签名类型"+c.Type)
+	}
+}
+
+// NewSweepSigner 按配置创建归集签名，remote时由签名服务派生私钥，未配置时签名报错
+func NewSweepSigner(c *conf.Signer, logger log.Logger) (biz.SweepSigner, func(), error) {
+	l := log.NewHelper(logger)
+	cleanup := func() {}
+
+	if nil == c {
+		c = &conf.Signer{}
+	}
+
+	if "remote" == c.Type {
+		if nil == c.Remote {
+			return nil, cleanup, errors.New(500, "SIGNER_ERROR", "签名服务未配置")
+		}
+		timeout := 10 * time.Second
+		if nil != c.Remote.Timeout && 0 < c.Remote.Timeout.AsDuration() {
+			timeout = c.Remote.Timeout.AsDuration()
+		}
+		signer, err := NewRemoteSigner(c.Remote.Addr, os.Getenv(c.Remote.TokenEnv), timeout)
+		if nil != err {
+			return nil, cleanup, err
+		}
+		return signer, func() {
+			if err := signer.Close(); nil != err {
+				l.Error(err)
+			}
+		}, nil
+	}
+
+	if nil == c.Hd || "" == os.Getenv(c.Hd.XprvEnv) {
+		return &HDSweepSigner{}, cleanup, nil
+	}
+	signer, err := NewHDSweepSigner(os.Getenv(c.Hd.XprvEnv))
+	if nil != err {
+		return nil, cleanup, err
+	}
+	return signer, cleanup, nil
+}
+
+// MemorySigner 内存私钥签名，测试时用临时私钥
+type MemorySigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+func NewMemorySigner(privateKey *ecdsa.PrivateKey) *MemorySigner {
+	return &MemorySigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+func (s *MemorySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *MemorySigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.NewEIP155Signer(chainId), s.privateKey)
+}
+
+// KeystoreSigner 加密keystore文件，启动时用环境变量中的密码解锁
+type KeystoreSigner struct {
+	*MemorySigner
+}
+
+func NewKeystoreSigner(path string, passphrase string) (*KeystoreSigner, error) {
+	keyJson, err := ioutil.ReadFile(path)
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_ERROR", "keystore读取失败")
+	}
+
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_ERROR", "keystore解锁失败")
+	}
+
+	return &KeystoreSigner{MemorySigner: NewMemorySigner(key.PrivateKey)}, nil
+}
+
+// HDSweepSigner 由m/44'/60'/0'/0的扩展私钥派生充值地址私钥
+type HDSweepSigner struct {
+	key *bip32.Key
+}
+
+func NewHDSweepSigner(xprv string) (*HDSweepSigner, error) {
+	key, err := bip32.B58Deserialize(xprv)
+	if nil != err || !key.IsPrivate {
+		return nil, errors.New(500, "SIGNER_ERROR", "扩展私钥错误")
+	}
+
+	return &HDSweepSigner{key: key}, nil
+}
+
+func (s *HDSweepSigner) SignSweepTx(ctx context.Context, index int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	privateKey, err := s.childKey(index)
+	if nil != err {
+		return nil, err
+	}
+
+	return types.SignTx(tx, types.NewEIP155Signer(chainId), privateKey)
+}
+
+// DeriveAddress 签名服务校验补gas的收款地址
+func (s *HDSweepSigner) DeriveAddress(index int64) (string, error) {
+	privateKey, err := s.childKey(index)
+	if nil != err {
+		return "", err
+	}
+
+	return strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex()), nil
+}
+
+func (s *HDSweepSigner) childKey(index int64) (*ecdsa.PrivateKey, error) {
+	if nil == s.key {
+		return nil, errors.New(500, "SIGNER_ERROR", "归集签名未配置")
+	}
+	if 0 > index || bip32.FirstHardenedChild <= uint32(index) || index != int64(uint32(index)) {
+		return nil, errors.New(500, "SIGNER_ERROR", "派生索引错误")
+	}
+
+	child, err := s.key.NewChildKey(uint32(index))
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_ERROR", err.Error())
+	}
+	privateKey, err := crypto.ToECDSA(child.Key)
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_ERROR", err.Error())
+	}
+
+	return privateKey, nil
+}
+
+// RemoteSigner 通过grpc调用cmd/signer签名，本进程不持有私钥
+type RemoteSigner struct {
+	conn    *grpc2.ClientConn
+	client  v1.SignerClient
+	address common.Address
+	timeout time.Duration
+}
+
+func NewRemoteSigner(addr string, token string, timeout time.Duration, opts ...grpc.ClientOption) (*RemoteSigner, error) {
+	if "" == token {
+		return nil, errors.New(500, "SIGNER_ERROR", "签名服务密钥未配置")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	opts = append([]grpc.ClientOption{
+		grpc.WithEndpoint(addr),
+		grpc.WithTimeout(timeout),
+		grpc.WithMiddleware(auth.SharedTokenClient(token)),
+	}, opts...)
+	conn, err := grpc.DialInsecure(ctx, opts...)
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_ERROR", "签名服务连接失败")
+	}
+
+	s := &RemoteSigner{conn: conn, client: v1.NewSignerClient(conn), timeout: timeout}
+	reply, err := s.client.SignerAddress(ctx, &v1.SignerAddressRequest{})
+	if nil != err {
+		_ = conn.Close()
+		return nil, err
+	}
+	s.address = common.HexToAddress(reply.Address)
+
+	return s, nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return s.signTx(ctx, 0, tx, chainId)
+}
+
+// SignGasTx 给充值地址补gas，签名服务按派生索引校验收款地址
+func (s *RemoteSigner) SignGasTx(ctx context.Context, index int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return s.signTx(ctx, index, tx, chainId)
+}
+
+func (s *RemoteSigner) signTx(ctx context.Context, depositIndex int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	b, err := rlp.EncodeToBytes(tx)
+	if nil != err {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	reply, err := s.client.SignTx(ctx, &v1.SignTxRequest{Tx: b, ChainId: chainId.Int64(), DepositIndex: depositIndex})
+	if nil != err {
+		return nil, err
+	}
+
+	var signedTx types.Transaction
+	if err = rlp.DecodeBytes(reply.Tx, &signedTx); nil != err {
+		return nil, err
+	}
+
+	// 确认签名地址和交易内容没有被替换
+	eip155Signer := types.NewEIP155Signer(chainId)
+	from, err := types.Sender(eip155Signer, &signedTx)
+	if nil != err || from != s.address || eip155Signer.Hash(&signedTx) != eip155Signer.Hash(tx) {
+		return nil, errors.New(500, "SIGNER_ERROR", "签名结果校验失败")
+	}
+
+	return &signedTx, nil
+}
+
+// SignSweepTx 签名地址由调用方核对
+func (s *RemoteSigner) SignSweepTx(ctx context.Context, index int64, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	b, err := rlp.EncodeToBytes(tx)
+	if nil != err {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	reply, err := s.client.SignSweepTx(ctx, &v1.SignSweepTxRequest{Index: index, Tx: b, ChainId: chainId.Int64()})
+	if nil != err {
+		return nil, err
+	}
+
+	var signedTx types.Transaction
+	if err = rlp.DecodeBytes(reply.Tx, &signedTx); nil != err {
+		return nil, err
+	}
+
+	eip155Signer := types.NewEIP155Signer(chainId)
+	if _, err = types.Sender(eip155Signer, &signedTx); nil != err || eip155Signer.Hash(&signedTx) != eip155Signer.Hash(tx) {
+		return nil, errors.New(500, "SIGNER_ERROR", "签名结果校验失败")
+	}
+
+	return &signedTx, nil
+}
+
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"encoding/hex"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"os"
+	"testing"
+)
+
+func testTx() *types.Transaction {
+	return types.NewTransaction(1, common.HexToAddress("0x55d398326f99059fF775485246999027B3197955"), big.NewInt(0), 21000, big.NewInt(5000000000), nil)
+}
+
+func TestMemorySignerSignTx(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+	signer := NewMemorySigner(privateKey)
+
+	chainId := big.NewInt(56)
+	signedTx, err := signer.SignTx(context.Background(), testTx(), chainId)
+	if nil != err {
+		t.Fatal(err)
+	}
+	from, err := types.Sender(types.NewEIP155Signer(chainId), signedTx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if from != signer.Address() {
+		t.Fatalf("sender %s, want %s", from.Hex(), signer.Address().Hex())
+	}
+}
+
+func TestNewSignerMemory(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+	const env = "DHB_TEST_SIGNER_PRIVATE_KEY"
+	if err = os.Setenv(env, "0x"+hex.EncodeToString(crypto.FromECDSA(privateKey))); nil != err {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv(env)
+
+	signer, cleanup, err := NewSigner(&conf.Signer{Type: "memory", Memory: &conf.Signer_Memory{PrivateKeyEnv: env}}, log.DefaultLogger)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	if signer.Address() != crypto.PubkeyToAddress(privateKey.PublicKey) {
+		t.Fatalf("address %s", signer.Address().Hex())
+	}
+}
+
+func TestNewSignerNotConfigured(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *conf.Signer
+	}{
+		{"nil", nil},
+		{"empty type", &conf.Signer{}},
+		{"memory without env", &conf.Signer{Type: "memory"}},
+		{"memory env unset", &conf.Signer{Type: "memory", Memory: &conf.Signer_Memory{PrivateKeyEnv: "DHB_TEST_SIGNER_UNSET"}}},
+		{"keystore without path", &conf.Signer{Type: "keystore"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if signer, _, err := NewSigner(tt.c, log.DefaultLogger); nil == err {
+				t.Fatalf("want error, got signer %s", signer.Address().Hex())
+			}
+		})
+	}
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(privateKey, "passphrase")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if _, err = NewKeystoreSigner(account.URL.Path, "wrong"); nil == err {
+		t.Fatal("want error for wrong passphrase")
+	}
+
+	signer, err := NewKeystoreSigner(account.URL.Path, "passphrase")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if signer.Address() != account.Address {
+		t.Fatalf("address %s, want %s", signer.Address().Hex(), account.Address.Hex())
+	}
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+type Sweep struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	Address    string    `gorm:"type:varchar(100);not null"`
+	PathIndex  int64     `gorm:"type:int;not null"`
+	Symbol     string    `gorm:"type:varchar(45);not null"`
+	Amount     string    `gorm:"type:varchar(100);not null"`
+	GasPrice   string    `gorm:"type:varchar(100);not null"`
+	FundTxHash string    `gorm:"type:varchar(100);not null"`
+	TxHash     string    `gorm:"type:varchar(100);not null"`
+	Nonce      int64     `gorm:"type:bigint;not null"`
+	Retry      int64     `gorm:"type:int;not null"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	Remark     string    `gorm:"type:varchar(255);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type SweepRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSweepRepo(data *Data, logger log.Logger) biz.SweepRepo {
+	return &SweepRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// NewSweepConfig 归集到链配置的收款地址
+func NewSweepConfig(c *conf.Chain) *biz.SweepConfig {
+	return &biz.SweepConfig{CollectionAddress: c.CollectionAddress}
+}
+
+// CreateSweep .
+func (s *SweepRepo) CreateSweep(ctx context.Context, bs *biz.Sweep) (*biz.Sweep, error) {
+	var sweep Sweep
+	sweep.Address = strings.ToLower(bs.Address)
+	sweep.PathIndex = bs.PathIndex
+	sweep.Symbol = bs.Symbol
+	sweep.Amount = bs.Amount
+	sweep.GasPrice = bs.GasPrice
+	sweep.Status = bs.Status
+	if err := s.data.DB(ctx).Table("sweep").Create(&sweep).Error; err != nil {
+		return nil, errors.New(500, "CREATE_SWEEP_ERROR", "归集记录创建失败")
+	}
+
+	return sweepToBiz(&sweep), nil
+}
+
+// UpdateSweep .
+func (s *SweepRepo) UpdateSweep(ctx context.Context, bs *biz.Sweep, from string) error {
+	res := s.data.DB(ctx).Table("sweep").Where("id=? and status=?", bs.ID, from).
+		Updates(map[string]interface{}{
+			"amount":       bs.Amount,
+			"gas_price":    bs.GasPrice,
+			"fund_tx_hash": bs.FundTxHash,
+			"tx_hash":      bs.TxHash,
+			"nonce":        bs.Nonce,
+			"retry":        bs.Retry,
+			"status":       bs.Status,
+			"remark":       bs.Remark,
+			"updated_at":   time.Now(),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_SWEEP_ERROR", "归集记录修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_SWEEP_ERROR", "归集状态已变化")
+	}
+
+	return nil
+}
+
+// GetSweepsByStatus .
+func (s *SweepRepo) GetSweepsByStatus(ctx context.Context, status ...string) ([]*biz.Sweep, error) {
+	var sweeps []*Sweep
+	res := make([]*biz.Sweep, 0)
+	if err := s.data.DB(ctx).Table("sweep").Where("status in(?)", status).Order("id asc").Find(&sweeps).Error; err != nil {
+		return nil, errors.New(500, "SWEEP ERROR", err.Error())
+	}
+
+	for _, v := range sweeps {
+		res = append(res, sweepToBiz(v))
+	}
+
+	return res, nil
+}
+
+func sweepToBiz(s *Sweep) *biz.Sweep {
+	return &biz.Sweep{
+		ID:         s.ID,
+		Address:    s.Address,
+		PathIndex:  s.PathIndex,
+		Symbol:     s.Symbol,
+		Amount:     s.Amount,
+		GasPrice:   s.GasPrice,
+		FundTxHash: s.FundTxHash,
+		TxHash:     s.TxHash,
+		Nonce:      s.Nonce,
+		Retry:      s.Retry,
+		Status:     s.Status,
+		Remark:     s.Remark,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type Token struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Symbol      string    `gorm:"type:varchar(45);not null"`
+	Contract    string    `gorm:"type:varchar(100);not null"`
+	Decimals    int64     `gorm:"type:int;not null"`
+	ChainId     int64     `gorm:"type:int;not null"`
+	Status      int64     `gorm:"type:int;not null"`
+	DepositRule string    `gorm:"type:varchar(45);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type TokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTokenRepo(data *Data, logger log.Logger) biz.TokenRepo {
+	return &TokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetTokens .
+func (t *TokenRepo) GetTokens(ctx context.Context) ([]*biz.Token, error) {
+	var tokens []*Token
+	res := make([]*biz.Token, 0)
+	if err := t.data.DB(ctx).Table("token").Order("id asc").Find(&tokens).Error; err != nil {
+		return nil, errors.New(500, "TOKEN ERROR", err.Error())
+	}
+
+	for _, v := range tokens {
+		res = append(res, tokenToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetTokenBySymbol .
+func (t *TokenRepo) GetTokenBySymbol(ctx context.Context, symbol string) (*biz.Token, error) {
+	var token Token
+	if err := t.data.DB(ctx).Table("token").
+		Where("symbol=?", strings.ToUpper(symbol)).Where("status=?", 1).
+		First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("TOKEN_NOT_FOUND", "token not found")
+		}
+
+		return nil, errors.New(500, "TOKEN ERROR", err.Error())
+	}
+
+	return tokenToBiz(&token), nil
+}
+
+// CreateToken .
+func (t *TokenRepo) CreateToken(ctx context.Context, bt *biz.Token) (*biz.Token, error) {
+	var token Token
+	token.Symbol = bt.Symbol
+	token.Contract = bt.Contract
+	token.Decimals = bt.Decimals
+	token.ChainId = bt.ChainId
+	token.Status = bt.Status
+	token.DepositRule = bt.DepositRule
+	if err := t.data.DB(ctx).Table("token").Create(&token).Error; err != nil {
+		return nil, errors.New(500, "CREATE_TOKEN_ERROR", "代币创建失败")
+	}
+
+	return tokenToBiz(&token), nil
+}
+
+// UpdateToken .
+func (t *TokenRepo) UpdateToken(ctx context.Context, bt *biz.Token) error {
+	res := t.data.DB(ctx).Table("token").Where("id=?", bt.ID).
+		Updates(map[string]interface{}{
+			"symbol":       bt.Symbol,
+			"contract":     bt.Contract,
+			"decimals":     bt.Decimals,
+			"chain_id":     bt.ChainId,
+			"status":       bt.Status,
+			"deposit_rule": bt.DepositRule,
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_TOKEN_ERROR", "代币修改失败")
+	}
+
+	return nil
+}
+
+func tokenToBiz(token *Token) *biz.Token {
+	return &biz.Token{
+		ID:          token.ID,
+		Symbol:      token.Symbol,
+		Contract:    token.Contract,
+		Decimals:    token.Decimals,
+		ChainId:     token.ChainId,
+		Status:      token.Status,
+		DepositRule: token.DepositRule,
+		CreatedAt:   token.CreatedAt,
+	}
+}
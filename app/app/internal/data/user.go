@@ -87,11 +87,23 @@ type Withdraw struct {
 	RelAmount       int64     `gorm:"type:bigint"`
 	Status          string    `gorm:"type:varchar(45);not null"`
 	Type            string    `gorm:"type:varchar(45);not null"`
+	Reason          string    `gorm:"type:varchar(255);not null"`
+	TxHash          string    `gorm:"type:varchar(100);not null"`
 	BalanceRecordId int64     `gorm:"type:int"`
 	CreatedAt       time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
 
+type WithdrawEvent struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	WithdrawId int64     `gorm:"type:int;not null"`
+	FromStatus string    `gorm:"type:varchar(45);not null"`
+	ToStatus   string    `gorm:"type:varchar(45);not null"`
+	Remark     string    `gorm:"type:varchar(255);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserBalanceRecord struct {
 	ID        int64     `gorm:"primarykey;type:int"`
 	UserId    int64     `gorm:"type:int"`
@@ -1146,12 +1158,21 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 
 // Deposit .
 func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	return ub.deposit(ctx, userId, amount, dhbAmount, "deposit")
+}
+
+// TopUp 追加到运行中仓位的充值
+func (ub *UserBalanceRepo) TopUp(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	return ub.deposit(ctx, userId, amount, dhbAmount, "top_up")
+}
+
+func (ub *UserBalanceRepo) deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64, recordType string) (int64, error) {
 	var err error
-	//if err = ub.data.DB(ctx).Table("user_balance").
-	//	Where("user_id=?", userId).
-	//	Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", dhbAmount)}).Error; nil != err {
-	//	return 0, errors.NotFound("user balance err", "user balance not found")
-	//}
+	if 0 < dhbAmount { // 配对充值的dhb入余额
+		if _, err = ub.DepositBalance(ctx, userId, dhbAmount, "dhb"); nil != err {
+			return 0, err
+		}
+	}
 
 	var userBalance UserBalance
 	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
@@ -1162,7 +1183,7 @@ func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
-	userBalanceRecode.Type = "deposit"
+	userBalanceRecode.Type = recordType
 	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
@@ -1173,6 +1194,50 @@ func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int
 	return userBalanceRecode.ID, nil
 }
 
+// DepositBalance 充值直接入usdt或dhb余额
+func (ub *UserBalanceRepo) DepositBalance(ctx context.Context, userId int64, amount int64, coinType string) (int64, error) {
+	var (
+		column string
+		err    error
+	)
+	if "usdt" == coinType {
+		column = "balance_usdt"
+	} else if "dhb" == coinType {
+		column = "balance_dhb"
+	} else {
+		return 0, errors.New(500, "DEPOSIT_ERROR", "币种错误")
+	}
+
+	if err = ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount)}).Error; nil != err {
+		return 0, errors.NotFound("user balance err", "user balance not found")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	if "usdt" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceUsdt
+	} else {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "deposit"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // UpdateLocationAgain .
 func (ub *UserBalanceRepo) UpdateLocationAgain(ctx context.Context, locations []*biz.LocationNew) error {
 	var (
@@ -1352,18 +1417,22 @@ func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount
 }
 
 // WithdrawUsdt .
-func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) error {
+func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
+	res := ub.data.DB(ctx).Table("user_balance").
 		Where("user_id=? and balance_usdt>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)})
+	if nil != res.Error {
+		return 0, errors.New(500, "USER BALANCE ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected { // 余额不足
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	var userBalance UserBalance
 	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
@@ -1374,25 +1443,29 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return userBalanceRecode.ID, nil
 }
 
 // WithdrawDhb .
-func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) error {
+func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
+	res := ub.data.DB(ctx).Table("user_balance").
 		Where("user_id=? and balance_dhb>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount)})
+	if nil != res.Error {
+		return 0, errors.New(500, "USER BALANCE ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected { // 余额不足
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	var userBalance UserBalance
 	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
@@ -1403,18 +1476,19 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return userBalanceRecode.ID, nil
 }
 
 // GreateWithdraw .
-func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*biz.Withdraw, error) {
+func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, balanceRecordId int64) (*biz.Withdraw, error) {
 	var withdraw Withdraw
 	withdraw.UserId = userId
 	withdraw.Amount = amount
 	withdraw.Type = coinType
+	withdraw.BalanceRecordId = balanceRecordId
 	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -1426,74 +1500,217 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amo
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
 		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
+		Status:          biz.WithdrawStatus(withdraw.Status),
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		TxHash:          withdraw.TxHash,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
 
-// UpdateWithdraw .
-func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status string) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
+// UpdateWithdrawStatus 只有当前状态为from时才能修改
+func (ub *UserBalanceRepo) UpdateWithdrawStatus(ctx context.Context, id int64, from biz.WithdrawStatus, to biz.WithdrawStatus) error {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=?", id).Where("status=?", string(from)).
+		Updates(map[string]interface{}{"status": string(to)})
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现状态已变更")
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return nil
 }
 
-// UpdateWithdrawAmount .
-func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	withdraw.RelAmount = amount
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
+// UpdateWithdrawAmount 修改状态同时写入实际到账金额
+func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, from biz.WithdrawStatus, to biz.WithdrawStatus, amount int64) error {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=?", id).Where("status=?", string(from)).
+		Updates(map[string]interface{}{"status": string(to), "rel_amount": amount})
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现状态已变更")
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return nil
 }
 
-// UpdateWithdrawPass .
-func (ub *UserBalanceRepo) UpdateWithdrawPass(ctx context.Context, id int64) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = "pass"
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", "rewarded").Updates(&withdraw)
+// CreateWithdrawEvent .
+func (ub *UserBalanceRepo) CreateWithdrawEvent(ctx context.Context, e *biz.WithdrawEvent) error {
+	var withdrawEvent WithdrawEvent
+	withdrawEvent.WithdrawId = e.WithdrawId
+	withdrawEvent.FromStatus = string(e.FromStatus)
+	withdrawEvent.ToStatus = string(e.ToStatus)
+	withdrawEvent.Remark = e.Remark
+	res := ub.data.DB(ctx).Table("withdraw_event").Create(&withdrawEvent)
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return errors.New(500, "CREATE_WITHDRAW_EVENT_ERROR", "提现记录创建失败")
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return nil
+}
+
+// GetWithdrawEvents .
+func (ub *UserBalanceRepo) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*biz.WithdrawEvent, error) {
+	var withdrawEvents []*WithdrawEvent
+	res := make([]*biz.WithdrawEvent, 0)
+	if err := ub.data.DB(ctx).Table("withdraw_event").Where("withdraw_id=?", withdrawId).Order("id asc").Find(&withdrawEvents).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW EVENT ERROR", err.Error())
+	}
+
+	for _, v := range withdrawEvents {
+		res = append(res, &biz.WithdrawEvent{
+			ID:         v.ID,
+			WithdrawId: v.WithdrawId,
+			FromStatus: biz.WithdrawStatus(v.FromStatus),
+			ToStatus:   biz.WithdrawStatus(v.ToStatus),
+			Remark:     v.Remark,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateWithdrawReason 记录拒绝原因
+func (ub *UserBalanceRepo) UpdateWithdrawReason(ctx context.Context, id int64, reason string) error {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"reason": reason})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawTxHash .
+func (ub *UserBalanceRepo) UpdateWithdrawTxHash(ctx context.Context, id int64, txHash string) error {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"tx_hash": txHash})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawsByStatus .
+func (ub *UserBalanceRepo) GetWithdrawsByStatus(ctx context.Context, status biz.WithdrawStatus) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := ub.data.db.Table("withdraw").Where("status=?", string(status)).Order("id asc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          biz.WithdrawStatus(withdraw.Status),
+			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// RefundWithdraw 提现金额退回余额，写余额记录和奖励记录
+func (ub *UserBalanceRepo) RefundWithdraw(ctx context.Context, withdrawId int64, userId int64, amount int64, coinType string) (int64, error) {
+	var err error
+	column := "balance_usdt"
+	if "dhb" == coinType {
+		column = "balance_dhb"
+	}
+
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return 0, errors.NotFound("user balance err", "user balance error")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "withdraw_refund"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = "withdraw" // 本次分红的行为类型
+	reward.TypeRecordId = withdrawId
+	reward.Reason = "withdraw_refund" // 给我分红的理由
+	reward.LocationType = coinType
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// GetSystemFeeByWithdrawId 提现时收取的手续费
+func (ub *UserBalanceRepo) GetSystemFeeByWithdrawId(ctx context.Context, withdrawId int64) (int64, error) {
+	var total UserBalanceTotal
+	if err := ub.data.DB(ctx).Table("reward").
+		Where("type=?", "withdraw").
+		Where("reason=?", "system_fee").
+		Where("type_record_id=?", withdrawId).
+		Select("sum(amount) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return total.Total, nil
+		}
+
+		return total.Total, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// SystemFeeRefund 退回提现手续费，冲减系统手续费
+func (ub *UserBalanceRepo) SystemFeeRefund(ctx context.Context, amount int64, withdrawId int64) error {
+	var (
+		reward Reward
+		err    error
+	)
+	reward.UserId = 999999999
+	reward.Amount = -amount
+	reward.BalanceRecordId = 999999999
+	reward.Type = "withdraw_refund" // 本次分红的行为类型
+	reward.TypeRecordId = withdrawId
+	reward.Reason = "system_fee" // 给我分红的理由
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // GetWithdrawByUserId .
@@ -1515,8 +1732,10 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId(ctx context.Context, userId int64
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
 			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
+			Status:          biz.WithdrawStatus(withdraw.Status),
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1542,8 +1761,10 @@ func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withd
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
 			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
+			Status:          biz.WithdrawStatus(withdraw.Status),
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1569,8 +1790,10 @@ func (ub *UserBalanceRepo) GetWithdrawByUserIds(ctx context.Context, userIds []i
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
 			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
+			Status:          biz.WithdrawStatus(withdraw.Status),
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1592,8 +1815,10 @@ func (ub *UserBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
 		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
+		Status:          biz.WithdrawStatus(withdraw.Status),
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		TxHash:          withdraw.TxHash,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1632,8 +1857,10 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
 			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
+			Status:          biz.WithdrawStatus(withdraw.Status),
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1659,18 +1886,24 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewarded(ctx context.Context) ([]*bi
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
 			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
+			Status:          biz.WithdrawStatus(withdraw.Status),
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			TxHash:          withdraw.TxHash,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
 	return res, nil
 }
 
-// GetWithdrawPassOrRewardedFirst .
-func (ub *UserBalanceRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Withdraw, error) {
+// GetWithdrawPassOrRewardedFirst 跳过excludeIds中本轮已处理不了的记录
+func (ub *UserBalanceRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context, excludeIds ...int64) (*biz.Withdraw, error) {
 	var withdraw *Withdraw
-	if err := ub.data.db.Table("withdraw").Where("status=?", "pass").First(&withdraw).Error; err != nil {
+	instance := ub.data.db.Table("withdraw").Where("status=?", "pass")
+	if 0 < len(excludeIds) {
+		instance = instance.Where("id not in (?)", excludeIds)
+	}
+	if err := instance.First(&withdraw).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
 		}
@@ -1684,8 +1917,10 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
 		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
+		Status:          biz.WithdrawStatus(withdraw.Status),
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		TxHash:          withdraw.TxHash,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1845,6 +2080,58 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 	return nil
 }
 
+// GetUserBalanceRecordById .
+func (ub *UserBalanceRepo) GetUserBalanceRecordById(ctx context.Context, id int64) (*biz.UserBalanceRecord, error) {
+	var userBalanceRecord UserBalanceRecord
+	if err := ub.data.DB(ctx).Table("user_balance_record").Where("id=?", id).First(&userBalanceRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("USER_BALANCE_RECORD_NOT_FOUND", "user balance record not found")
+		}
+
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	return &biz.UserBalanceRecord{
+		ID:        userBalanceRecord.ID,
+		UserId:    userBalanceRecord.UserId,
+		Balance:   userBalanceRecord.Balance,
+		Amount:    userBalanceRecord.Amount,
+		Type:      userBalanceRecord.Type,
+		CoinType:  userBalanceRecord.CoinType,
+		CreatedAt: userBalanceRecord.CreatedAt,
+	}, nil
+}
+
+// GetRewardsByLocationNewId 仓位产生的收益：reason_location_id为仓位id的直推和每日收益，type_record_id为仓位id的入单、追加(top_up)奖励和团队奖励，
+// 其他reason的reason_location_id是级别或层数不是仓位id
+func (ub *UserBalanceRepo) GetRewardsByLocationNewId(ctx context.Context, locationId int64) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+	if err := ub.data.DB(ctx).Table("reward").
+		Where("(reason in(?) and reason_location_id=?) or (type in(?) and type_record_id=?)",
+			[]string{"recommend", "location_daily_reward"}, locationId, []string{"location", "system_reward_daily"}, locationId).
+		Order("id asc").Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
 // GetSystemYesterdayDailyReward .
 func (ub *UserBalanceRepo) GetSystemYesterdayDailyReward(ctx context.Context, day int) (*biz.Reward, error) {
 	var reward Reward
@@ -2236,6 +2523,7 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 	reward.Type = "location" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "recommend" // 给我分红的理由
+	reward.ReasonLocationId = locationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -2486,6 +2774,78 @@ func (ub *UserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination
 	return res, nil, count
 }
 
+// rewardFilter .
+func (ub *UserBalanceRepo) rewardFilter(f *biz.RewardFilter) *gorm.DB {
+	instance := ub.data.db.Table("reward").Where("user_id=?", f.UserId)
+
+	if 0 < len(f.Reasons) {
+		instance = instance.Where("reason IN (?)", f.Reasons)
+	}
+
+	if "" != f.Type {
+		instance = instance.Where("type=?", f.Type)
+	}
+
+	if !f.StartTime.IsZero() {
+		instance = instance.Where("created_at>=?", f.StartTime)
+	}
+
+	if !f.EndTime.IsZero() {
+		instance = instance.Where("created_at<?", f.EndTime)
+	}
+
+	return instance
+}
+
+// GetUserRewardsByCursor 按id倒序，cursor为上一页最后一条的id
+func (ub *UserBalanceRepo) GetUserRewardsByCursor(ctx context.Context, f *biz.RewardFilter, cursor int64, limit int) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+
+	instance := ub.rewardFilter(f)
+	if 0 < cursor {
+		instance = instance.Where("id<?", cursor)
+	}
+
+	if err := instance.Order("id desc").Limit(limit).Find(&rewards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("REWARD_NOT_FOUND", "reward not found")
+		}
+
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// GetUserRewardsTotal 返回总金额和总条数
+func (ub *UserBalanceRepo) GetUserRewardsTotal(ctx context.Context, f *biz.RewardFilter) (int64, int64, error) {
+	var total struct {
+		Total int64
+		Count int64
+	}
+
+	if err := ub.rewardFilter(f).Select("coalesce(sum(amount), 0) as total, count(*) as count").Take(&total).Error; err != nil {
+		return 0, 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return total.Total, total.Count, nil
+}
+
 // GetUserRewardRecommendSort .
 func (ub *UserBalanceRepo) GetUserRewardRecommendSort(ctx context.Context) ([]*biz.UserSortRecommendReward, error) {
 	var total []*UserSortRecommendReward
@@ -2657,7 +3017,7 @@ func (ub UserBalanceRepo) GetUserBalanceDHBTotal(ctx context.Context) (int64, er
 func (ub UserBalanceRepo) GetUserBalanceRecordUsdtTotal(ctx context.Context) (int64, error) {
 	var total UserBalanceTotal
 	if err := ub.data.db.Table("user_balance_record").
-		Where("type=?", "deposit").
+		Where("type in(?)", []string{"deposit", "top_up"}).
 		Where("coin_type=?", "usdt").
 		Select("sum(amount) as total").Take(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -2688,7 +3048,7 @@ func (ub UserBalanceRepo) GetUserBalanceRecordUsdtTotalToday(ctx context.Context
 	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 16, 0, 0, 0, time.UTC)
 
 	if err := ub.data.db.Table("user_balance_record").
-		Where("type=?", "deposit").
+		Where("type in(?)", []string{"deposit", "top_up"}).
 		Where("coin_type=?", "usdt").
 		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).
 		Select("sum(amount) as total").Take(&total).Error; err != nil {
@@ -2702,6 +3062,30 @@ func (ub UserBalanceRepo) GetUserBalanceRecordUsdtTotalToday(ctx context.Context
 	return total.Total, nil
 }
 
+// GetUserWithdrawTotalTodayByUserId 用户当天(东八区)未退回的提现申请总额
+func (ub UserBalanceRepo) GetUserWithdrawTotalTodayByUserId(ctx context.Context, userId int64, coinType string) (int64, error) {
+	var total UserBalanceTotal
+	now := time.Now().UTC()
+	var startDate time.Time
+	if 16 <= now.Hour() {
+		startDate = now
+	} else {
+		startDate = now.AddDate(0, 0, -1)
+	}
+	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
+
+	if err := ub.data.DB(ctx).Table("withdraw").
+		Where("user_id=?", userId).
+		Where("type=?", coinType).
+		Where("status not in(?)", []string{string(biz.WithdrawStatusRejected), string(biz.WithdrawStatusRefunded)}). // 已退回的不占额度
+		Where("created_at>=?", todayStart).
+		Select("coalesce(sum(amount), 0) as total").Take(&total).Error; err != nil {
+		return 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
 // GetUserWithdrawUsdtTotalToday .
 func (ub UserBalanceRepo) GetUserWithdrawUsdtTotalToday(ctx context.Context) (int64, error) {
 	var total UserBalanceTotal
@@ -0,0 +1,196 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type WalletNonce struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Address   string    `gorm:"type:varchar(100);not null"`
+	Nonce     int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type WalletTx struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	Address    string    `gorm:"type:varchar(100);not null"`
+	Nonce      int64     `gorm:"type:bigint;not null"`
+	WithdrawId int64     `gorm:"type:int;not null"`
+	Kind       string    `gorm:"type:varchar(45);not null"`
+	TxHash     string    `gorm:"type:varchar(100);not null"`
+	ToAddress  string    `gorm:"type:varchar(100);not null"`
+	Value      string    `gorm:"type:varchar(100);not null"`
+	Data       string    `gorm:"type:text;not null"`
+	GasLimit   int64     `gorm:"type:bigint;not null"`
+	GasPrice   string    `gorm:"type:varchar(100);not null"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type WalletTxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWalletTxRepo(data *Data, logger log.Logger) biz.WalletTxRepo {
+	return &WalletTxRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// AllocateNonce 行锁保证多个进程拿到的nonce连续不重复
+func (w *WalletTxRepo) AllocateNonce(ctx context.Context, address string, chainNonce int64) (int64, error) {
+	var walletNonce WalletNonce
+	err := w.data.DB(ctx).Table("wallet_nonce").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("address=?", address).First(&walletNonce).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errors.New(500, "WALLET NONCE ERROR", err.Error())
+		}
+
+		walletNonce.Address = address
+		walletNonce.Nonce = chainNonce + 1
+		if err = w.data.DB(ctx).Table("wallet_nonce").Create(&walletNonce).Error; err != nil {
+			return 0, errors.New(500, "CREATE_WALLET_NONCE_ERROR", "钱包nonce创建失败")
+		}
+
+		return chainNonce, nil
+	}
+
+	// 钱包在系统外发过交易时以链上为准
+	nonce := walletNonce.Nonce
+	if chainNonce > nonce {
+		nonce = chainNonce
+	}
+
+	if err = w.data.DB(ctx).Table("wallet_nonce").Where("id=?", walletNonce.ID).
+		Updates(map[string]interface{}{"nonce": nonce + 1}).Error; err != nil {
+		return 0, errors.New(500, "UPDATE_WALLET_NONCE_ERROR", "钱包nonce修改失败")
+	}
+
+	return nonce, nil
+}
+
+// ReleaseNonce .
+func (w *WalletTxRepo) ReleaseNonce(ctx context.Context, address string, nonce int64) (bool, error) {
+	res := w.data.DB(ctx).Table("wallet_nonce").
+		Where("address=?", address).Where("nonce=?", nonce+1).
+		Updates(map[string]interface{}{"nonce": nonce})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_WALLET_NONCE_ERROR", "钱包nonce修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// CreateWalletTx .
+func (w *WalletTxRepo) CreateWalletTx(ctx context.Context, wt *biz.WalletTx) (*biz.WalletTx, error) {
+	var walletTx WalletTx
+	walletTx.Address = wt.Address
+	walletTx.Nonce = wt.Nonce
+	walletTx.WithdrawId = wt.WithdrawId
+	walletTx.Kind = wt.Kind
+	walletTx.TxHash = wt.TxHash
+	walletTx.ToAddress = wt.ToAddress
+	walletTx.Value = wt.Value
+	walletTx.Data = wt.Data
+	walletTx.GasLimit = wt.GasLimit
+	walletTx.GasPrice = wt.GasPrice
+	walletTx.Status = wt.Status
+	if err := w.data.DB(ctx).Table("wallet_tx").Create(&walletTx).Error; err != nil {
+		return nil, errors.New(500, "CREATE_WALLET_TX_ERROR", "钱包交易记录创建失败")
+	}
+
+	return walletTxToBiz(&walletTx), nil
+}
+
+// UpdateWalletTxStatus 只有当前状态为from时才能修改
+func (w *WalletTxRepo) UpdateWalletTxStatus(ctx context.Context, id int64, from string, to string) error {
+	res := w.data.DB(ctx).Table("wallet_tx").
+		Where("id=?", id).Where("status=?", from).
+		Updates(map[string]interface{}{"status": to})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_WALLET_TX_ERROR", "钱包交易记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_WALLET_TX_ERROR", "钱包交易状态已变更")
+	}
+
+	return nil
+}
+
+// GetWalletTxById .
+func (w *WalletTxRepo) GetWalletTxById(ctx context.Context, id int64) (*biz.WalletTx, error) {
+	var walletTx WalletTx
+	if err := w.data.DB(ctx).Table("wallet_tx").Where("id=?", id).First(&walletTx).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("WALLET_TX_NOT_FOUND", "wallet tx not found")
+		}
+
+		return nil, errors.New(500, "WALLET TX ERROR", err.Error())
+	}
+
+	return walletTxToBiz(&walletTx), nil
+}
+
+// GetWalletTxsByStatus .
+func (w *WalletTxRepo) GetWalletTxsByStatus(ctx context.Context, address string, status ...string) ([]*biz.WalletTx, error) {
+	var walletTxs []*WalletTx
+	res := make([]*biz.WalletTx, 0)
+	if err := w.data.DB(ctx).Table("wallet_tx").
+		Where("address=?", address).Where("status IN (?)", status).
+		Order("nonce asc").Find(&walletTxs).Error; err != nil {
+		return nil, errors.New(500, "WALLET TX ERROR", err.Error())
+	}
+
+	for _, v := range walletTxs {
+		res = append(res, walletTxToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetWalletTxsByWithdrawId .
+func (w *WalletTxRepo) GetWalletTxsByWithdrawId(ctx context.Context, withdrawId int64) ([]*biz.WalletTx, error) {
+	var walletTxs []*WalletTx
+	res := make([]*biz.WalletTx, 0)
+	if err := w.data.DB(ctx).Table("wallet_tx").
+		Where("withdraw_id=?", withdrawId).
+		Order("id asc").Find(&walletTxs).Error; err != nil {
+		return nil, errors.New(500, "WALLET TX ERROR", err.Error())
+	}
+
+	for _, v := range walletTxs {
+		res = append(res, walletTxToBiz(v))
+	}
+
+	return res, nil
+}
+
+func walletTxToBiz(walletTx *WalletTx) *biz.WalletTx {
+	return &biz.WalletTx{
+		ID:         walletTx.ID,
+		Address:    walletTx.Address,
+		Nonce:      walletTx.Nonce,
+		WithdrawId: walletTx.WithdrawId,
+		Kind:       walletTx.Kind,
+		TxHash:     walletTx.TxHash,
+		ToAddress:  walletTx.ToAddress,
+		Value:      walletTx.Value,
+		Data:       walletTx.Data,
+		GasLimit:   walletTx.GasLimit,
+		GasPrice:   walletTx.GasPrice,
+		Status:     walletTx.Status,
+		CreatedAt:  walletTx.CreatedAt,
+		UpdatedAt:  walletTx.UpdatedAt,
+	}
+}
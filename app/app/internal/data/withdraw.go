@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+)
+
+// RpcWithdrawChain 通过节点rpc发送和查询打款交易
+type RpcWithdrawChain struct {
+	client *rpc.Client
+	log    *log.Helper
+}
+
+func NewWithdrawChain(c *conf.Chain, logger log.Logger) (biz.WithdrawChain, func(), error) {
+	if nil == c || "" == c.RpcUrl {
+		return nil, nil, errors.New(500, "WITHDRAW_CHAIN_ERROR", "节点未配置")
+	}
+
+	client, err := rpc.DialContext(context.Background(), c.RpcUrl)
+	if nil != err {
+		return nil, nil, errors.New(500, "WITHDRAW_CHAIN_ERROR", err.Error())
+	}
+
+	return &RpcWithdrawChain{
+		client: client,
+		log:    log.NewHelper(logger),
+	}, client.Close, nil
+}
+
+func (w *RpcWithdrawChain) GetChainId(ctx context.Context) (*big.Int, error) {
+	var chainId hexutil.Big
+	if err := w.client.CallContext(ctx, &chainId, "eth_chainId"); nil != err {
+		return nil, err
+	}
+
+	return (*big.Int)(&chainId), nil
+}
+
+func (w *RpcWithdrawChain) GetNonceAt(ctx context.Context, address string, pending bool) (int64, error) {
+	block := "latest"
+	if pending {
+		block = "pending"
+	}
+
+	var nonce hexutil.Uint64
+	if err := w.client.CallContext(ctx, &nonce, "eth_getTransactionCount", common.HexToAddress(address), block); nil != err {
+		return 0, err
+	}
+
+	return int64(nonce), nil
+}
+
+func (w *RpcWithdrawChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	var gasPrice hexutil.Big
+	if err := w.client.CallContext(ctx, &gasPrice, "eth_gasPrice"); nil != err {
+		return nil, err
+	}
+
+	return (*big.Int)(&gasPrice), nil
+}
+
+func (w *RpcWithdrawChain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	data, err := rlp.EncodeToBytes(tx)
+	if nil != err {
+		return err
+	}
+
+	return w.client.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data))
+}
+
+func (w *RpcWithdrawChain) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	var blockNumber hexutil.Uint64
+	if err := w.client.CallContext(ctx, &blockNumber, "eth_blockNumber"); nil != err {
+		return 0, err
+	}
+
+	return int64(blockNumber), nil
+}
+
+// GetTransactionReceipt go-ethereum的Receipt不带区块号，这里直接解析rpc返回
+func (w *RpcWithdrawChain) GetTransactionReceipt(ctx context.Context, hash string) (*biz.TxReceipt, error) {
+	var receipt *struct {
+		TransactionHash string         `json:"transactionHash"`
+		Status          hexutil.Uint64 `json:"status"`
+		BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+		BlockHash       string         `json:"blockHash"`
+	}
+	if err := w.client.CallContext(ctx, &receipt, "eth_getTransactionReceipt", hash); nil != err {
+		return nil, err
+	}
+	if nil == receipt { // 未上链
+		return nil, nil
+	}
+
+	return &biz.TxReceipt{
+		Hash:        receipt.TransactionHash,
+		Status:      uint64(receipt.Status),
+		BlockNumber: int64(receipt.BlockNumber),
+		BlockHash:   receipt.BlockHash,
+	}, nil
+}
+
+func (w *RpcWithdrawChain) GetBlockHash(ctx context.Context, blockNumber int64) (string, error) {
+	var block *struct {
+		Hash string `json:"hash"`
+	}
+	if err := w.client.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(uint64(blockNumber)), false); nil != err {
+		return "", err
+	}
+	if nil == block {
+		return "", nil
+	}
+
+	return block.Hash, nil
+}
+
+func (w *RpcWithdrawChain) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	var balance hexutil.Big
+	if err := w.client.CallContext(ctx, &balance, "eth_getBalance", common.HexToAddress(address), "latest"); nil != err {
+		return nil, err
+	}
+
+	return (*big.Int)(&balance), nil
+}
+
+// balanceOfMethodId erc20 balanceOf(address)
+var balanceOfMethodId = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+
+func (w *RpcWithdrawChain) GetTokenBalance(ctx context.Context, contract string, address string) (*big.Int, error) {
+	data := append(append([]byte{}, balanceOfMethodId...), common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32)...)
+
+	var res hexutil.Bytes
+	if err := w.client.CallContext(ctx, &res, "eth_call", map[string]interface{}{
+		"to":   common.HexToAddress(contract),
+		"data": hexutil.Bytes(data),
+	}, "latest"); nil != err {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(res), nil
+}
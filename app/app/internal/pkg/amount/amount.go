@@ -0,0 +1,150 @@
+package amount
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// SystemDecimals 系统内金额统一10位精度，1表示为10000000000
+const SystemDecimals = 10
+
+// Rounding 精度转换时多出位数的处理方式
+type Rounding int
+
+const (
+	// RoundExact 不允许丢失精度，有多余位数返回ErrPrecision
+	RoundExact Rounding = iota
+	// RoundDown 向零舍去
+	RoundDown
+	// RoundUp 远离零进位
+	RoundUp
+	// RoundHalfUp 四舍五入，.5远离零
+	RoundHalfUp
+)
+
+var (
+	ErrFormat    = errors.New("amount format error")
+	ErrPrecision = errors.New("amount precision exceeded")
+	ErrOverflow  = errors.New("amount overflow")
+	ErrDecimals  = errors.New("amount decimals error")
+)
+
+var ten = big.NewInt(10)
+
+// MaxDecimals 代币精度上限
+const MaxDecimals = 36
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(n), nil)
+}
+
+// quo 按舍入方式计算x/y，y为正数
+func quo(x, y *big.Int, mode Rounding) (*big.Int, error) {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if 0 == r.Sign() {
+		return q, nil
+	}
+
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	case RoundHalfUp:
+		if 0 <= new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(y) {
+			q.Add(q, big.NewInt(int64(x.Sign())))
+		}
+	default:
+		return nil, ErrPrecision
+	}
+
+	return q, nil
+}
+
+func toInt64(x *big.Int) (int64, error) {
+	if !x.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return x.Int64(), nil
+}
+
+// FromChain 链上最小单位转为系统金额
+func FromChain(value *big.Int, decimals int64, mode Rounding) (int64, error) {
+	if nil == value {
+		return 0, ErrFormat
+	}
+	if 0 > decimals || MaxDecimals < decimals {
+		return 0, ErrDecimals
+	}
+
+	if decimals <= SystemDecimals {
+		return toInt64(new(big.Int).Mul(value, pow10(SystemDecimals-decimals)))
+	}
+
+	res, err := quo(value, pow10(decimals-SystemDecimals), mode)
+	if nil != err {
+		return 0, err
+	}
+	return toInt64(res)
+}
+
+// ParseChain 链上最小单位的十进制字符串转为系统金额
+func ParseChain(value string, decimals int64, mode Rounding) (int64, error) {
+	v, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return 0, ErrFormat
+	}
+	return FromChain(v, decimals, mode)
+}
+
+// ToChain 系统金额转为链上最小单位
+func ToChain(amount int64, decimals int64, mode Rounding) (*big.Int, error) {
+	if 0 > decimals || MaxDecimals < decimals {
+		return nil, ErrDecimals
+	}
+
+	v := big.NewInt(amount)
+	if decimals >= SystemDecimals {
+		return v.Mul(v, pow10(decimals-SystemDecimals)), nil
+	}
+	return quo(v, pow10(SystemDecimals-decimals), mode)
+}
+
+// Parse 十进制金额字符串(如"12.5")转为系统金额，允许负号
+func Parse(s string, mode Rounding) (int64, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+
+	parts := strings.Split(s, ".")
+	if "" == s || 2 < len(parts) || "" == parts[0] {
+		return 0, ErrFormat
+	}
+	frac := ""
+	if 2 == len(parts) {
+		frac = parts[1]
+	}
+	for _, c := range parts[0] + frac {
+		if '0' > c || '9' < c {
+			return 0, ErrFormat
+		}
+	}
+
+	if MaxDecimals < len(frac) {
+		return 0, ErrPrecision
+	}
+
+	digits := parts[0] + frac
+	if neg {
+		digits = "-" + digits
+	}
+	v, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return 0, ErrFormat
+	}
+
+	return FromChain(v, int64(len(frac)), mode)
+}
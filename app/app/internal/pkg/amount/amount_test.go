@@ -0,0 +1,106 @@
+package amount
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"0", "1", "12.5", "-12.5", "0.00000000001", "0.00000000005", "-0.00000000005", "922337203.6854775807", "922337203.6854775808", "1.", ".5", "1.2.3", "+1", "1e5", " 7 ", "-", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		exact, errExact := Parse(s, RoundExact)
+		down, errDown := Parse(s, RoundDown)
+		up, errUp := Parse(s, RoundUp)
+		halfUp, errHalfUp := Parse(s, RoundHalfUp)
+
+		if nil != errDown {
+			if nil == errExact {
+				t.Fatalf("%q: exact ok, down %v", s, errDown)
+			}
+			return
+		}
+
+		// 与big.Rat计算的结果比较，RoundDown向零截断
+		r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+		if !ok {
+			t.Fatalf("%q: parsed %d, not a decimal", s, down)
+		}
+		r.Mul(r, new(big.Rat).SetInt(pow10(SystemDecimals)))
+		want := new(big.Int).Quo(r.Num(), r.Denom())
+		if !want.IsInt64() || want.Int64() != down {
+			t.Fatalf("%q: down %d, want %s", s, down, want)
+		}
+
+		if r.IsInt() {
+			if nil != errExact || exact != down {
+				t.Fatalf("%q: exact %d %v, want %d", s, exact, errExact, down)
+			}
+			if up != down || halfUp != down {
+				t.Fatalf("%q: up %d half up %d, want %d", s, up, halfUp, down)
+			}
+			return
+		}
+
+		if nil == errExact {
+			t.Fatalf("%q: exact %d, want precision error", s, exact)
+		}
+		if nil != errUp || nil != errHalfUp { // 进位后可能超出int64
+			return
+		}
+		step := int64(r.Sign())
+		if up != down+step || (halfUp != down && halfUp != up) {
+			t.Fatalf("%q: down %d up %d half up %d", s, down, up, halfUp)
+		}
+	})
+}
+
+func FuzzChainRoundTrip(f *testing.F) {
+	for _, d := range []int64{0, 6, 8, 10, 18, 36} {
+		f.Add(int64(1), d)
+		f.Add(int64(-12345678901), d)
+		f.Add(int64(9223372036854775807), d)
+	}
+
+	f.Fuzz(func(t *testing.T, amount int64, decimals int64) {
+		if 0 > decimals || MaxDecimals < decimals {
+			if _, err := ToChain(amount, decimals, RoundExact); ErrDecimals != err {
+				t.Fatalf("decimals %d: %v, want ErrDecimals", decimals, err)
+			}
+			return
+		}
+
+		// 系统金额 -> 链上 -> 系统金额，不丢精度时原样返回
+		value, err := ToChain(amount, decimals, RoundExact)
+		if nil != err {
+			if SystemDecimals <= decimals {
+				t.Fatalf("%d decimals %d: %v", amount, decimals, err)
+			}
+			if ErrPrecision != err {
+				t.Fatalf("%d decimals %d: %v, want ErrPrecision", amount, decimals, err)
+			}
+		} else {
+			back, err := FromChain(value, decimals, RoundExact)
+			if nil != err || back != amount {
+				t.Fatalf("%d decimals %d: chain %s back %d %v", amount, decimals, value, back, err)
+			}
+		}
+
+		// 链上 -> 系统金额 -> 链上，向零舍去后不会变大
+		chain := new(big.Int).Mul(big.NewInt(amount), big.NewInt(7))
+		system, err := FromChain(chain, decimals, RoundDown)
+		if nil != err {
+			return
+		}
+		value, err = ToChain(system, decimals, RoundExact)
+		if nil != err {
+			t.Fatalf("%s decimals %d: system %d %v", chain, decimals, system, err)
+		}
+		if 0 < new(big.Int).Abs(value).Cmp(new(big.Int).Abs(chain)) || (0 != value.Sign() && value.Sign() != chain.Sign()) {
+			t.Fatalf("%s decimals %d: back %s", chain, decimals, value)
+		}
+	})
+}
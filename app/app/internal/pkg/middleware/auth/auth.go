@@ -1,22 +1,85 @@
 package auth
 
 import (
+	"context"
+	"crypto/subtle"
 	"errors"
-	"github.com/golang-jwt/jwt/v4"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwt2 "github.com/golang-jwt/jwt/v4"
 )
 
+const (
+	UserTypeAdmin = "admin" // 后台账号
+	UserTypeUser  = "user"  // 钱包登录的用户
+)
+
+// sharedTokenHeader 调用签名服务时携带的共享密钥
+const sharedTokenHeader = "x-signer-token"
+
 type CustomClaims struct {
 	UserId   int64
 	UserType string
-	jwt.StandardClaims
+	jwt2.StandardClaims
 }
 
 // CreateToken generate token
 func CreateToken(c CustomClaims, key string) (string, error) {
-	claims := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	claims := jwt2.NewWithClaims(jwt2.SigningMethodHS256, c)
 	signedString, err := claims.SignedString([]byte(key))
 	if err != nil {
 		return "", errors.New("generate token failed" + err.Error())
 	}
 	return signedString, nil
 }
+
+// Role 按接口要求的用户类型校验token，放在jwt验证之后，userType返回接口需要的用户类型
+func Role(userType func(operation string) string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, kerrors.Forbidden("ERROR_ROLE", "无权限")
+			}
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, kerrors.Forbidden("ERROR_ROLE", "无权限")
+			}
+			c, ok := claims.(jwt2.MapClaims)
+			if !ok || userType(tr.Operation()) != c["UserType"] {
+				return nil, kerrors.Forbidden("ERROR_ROLE", "无权限")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// SharedTokenServer 校验调用方携带的共享密钥，token为空时拒绝所有请求
+func SharedTokenServer(token string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || "" == token || 1 != subtle.ConstantTimeCompare([]byte(token), []byte(tr.RequestHeader().Get(sharedTokenHeader))) {
+				return nil, kerrors.Unauthorized("ERROR_TOKEN", "无效TOKEN")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// SharedTokenClient 请求时带上共享密钥
+func SharedTokenClient(token string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				tr.RequestHeader().Set(sharedTokenHeader, token)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
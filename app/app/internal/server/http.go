@@ -4,26 +4,29 @@ import (
 	"context"
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/service"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	jwt2 "github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/handlers"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, ca *conf.Auth, app *service.AppService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			selector.Server( // jwt 验证
 				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
-					return []byte("f33933ab18063c2e008115594b901f67"), nil
+					return []byte(ca.JwtKey), nil
 				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+				auth.Role(OperationUserType), // 后台接口只允许后台账号
 			).Match(NewWhiteListMatcher()).Build(),
 		),
 		http.Filter(handlers.CORS(
@@ -46,27 +49,37 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 	return srv
 }
 
+// OperationUserType 接口需要的用户类型，Admin开头和后台任务接口需要后台账号，其余需要钱包登录的用户
+func OperationUserType(operation string) string {
+	adminList := make(map[string]struct{})
+	adminList["/api.App/Deposit"] = struct{}{}
+	adminList["/api.App/Deposit2"] = struct{}{}
+	adminList["/api.App/LockSystem"] = struct{}{}
+	adminList["/api.App/CheckAdminUserArea"] = struct{}{}
+	adminList["/api.App/CheckAndInsertLocationsRecommendUser"] = struct{}{}
+	adminList["/api.App/CheckAndInsertRecommendArea"] = struct{}{}
+	adminList["/api.App/AuthList"] = struct{}{}
+	adminList["/api.App/MyAuthList"] = struct{}{}
+	adminList["/api.App/UserAuthList"] = struct{}{}
+	adminList["/api.App/AuthAdminCreate"] = struct{}{}
+	adminList["/api.App/AuthAdminDelete"] = struct{}{}
+
+	if _, ok := adminList[operation]; ok || strings.HasPrefix(operation, "/api.App/Admin") {
+		return auth.UserTypeAdmin
+	}
+	return auth.UserTypeUser
+}
+
 // NewWhiteListMatcher 设置白名单，不需要 token 验证的接口
 func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
 	whiteList["/api.App/AdminLogin"] = struct{}{}
-	whiteList["/api.App/Deposit"] = struct{}{}
-	whiteList["/api.App/Deposit2"] = struct{}{}
+	whiteList["/api.App/EthAuthorizeNonce"] = struct{}{}
+	whiteList["/api.App/EthAuthorize"] = struct{}{}
 	//whiteList["/api.App/AdminLocationList"] = struct{}{}
 	//whiteList["/api.App/AdminRewardList"] = struct{}{}
 	//whiteList["/api.App/AdminUserList"] = struct{}{}
 	//whiteList["/api.App/AdminWithdrawList"] = struct{}{}
-	whiteList["/api.App/AdminWithdraw"] = struct{}{}
-	whiteList["/api.App/AdminWithdrawEth"] = struct{}{}
-	whiteList["/api.App/AdminFee"] = struct{}{}
-	whiteList["/api.App/AdminDailyFee"] = struct{}{}
-	whiteList["/api.App/CheckAndInsertRecommendArea"] = struct{}{}
-	whiteList["/api.App/AdminDailyRecommendReward"] = struct{}{}
-	whiteList["/api.App/CheckAndInsertLocationsRecommendUser"] = struct{}{}
-	whiteList["/api.App/CheckAdminUserArea"] = struct{}{}
-	whiteList["/api.App/AdminDailyLocationReward"] = struct{}{}
-	whiteList["/api.App/AdminDailyBalanceReward"] = struct{}{}
-	whiteList["/api.App/LockSystem"] = struct{}{}
 	//whiteList["/api.App/AdminAll"] = struct{}{}
 	//whiteList["/api.App/AdminConfigUpdate"] = struct{}{}
 	//whiteList["/api.App/AdminConfig"] = struct{}{}
@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/service"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"os"
+	"sync"
+	"time"
+)
+
+// 只删除自己持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// 只续期自己持有的锁
+var renewScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// dailyRetry 每日任务失败后的重试间隔
+const dailyRetry = 5 * time.Minute
+
+// JobServer 定时任务，多副本部署时通过redis选出一个副本执行每个任务
+type JobServer struct {
+	c      *conf.Job
+	rdb    *redis.Client
+	jobs   map[string]func(ctx context.Context) error
+	id     string
+	log    *log.Helper
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobServer new a job server.
+func NewJobServer(c *conf.Job, app *service.AppService, rdb *redis.Client, logger log.Logger) *JobServer {
+	hostname, _ := os.Hostname()
+	return &JobServer{
+		c:   c,
+		rdb: rdb,
+		id:  fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		log: log.NewHelper(logger),
+		jobs: map[string]func(ctx context.Context) error{
+			"deposit": func(ctx context.Context) error {
+				_, err := app.Deposit(ctx, &v1.DepositRequest{})
+				return err
+			},
+			"withdraw": func(ctx context.Context) error {
+				_, err := app.AdminWithdraw(ctx, &v1.AdminWithdrawRequest{})
+				return err
+			},
+			"withdraw_eth": func(ctx context.Context) error {
+				_, err := app.AdminWithdrawEth(ctx, &v1.AdminWithdrawEthRequest{})
+				return err
+			},
+			"withdraw_track": func(ctx context.Context) error {
+				_, err := app.AdminWithdrawTrack(ctx, &v1.AdminWithdrawTrackRequest{})
+				return err
+			},
+			"wallet_tx_check": func(ctx context.Context) error {
+				_, err := app.AdminWalletTxCheck(ctx, &v1.AdminWalletTxCheckRequest{})
+				return err
+			},
+			"sweep": func(ctx context.Context) error {
+				_, err := app.AdminSweep(ctx, &v1.AdminSweepRequest{})
+				return err
+			},
+			"deposit_reorg_check": func(ctx context.Context) error {
+				_, err := app.AdminDepositReorgCheck(ctx, &v1.AdminDepositReorgCheckRequest{})
+				return err
+			},
+			"daily_location_reward": func(ctx context.Context) error {
+				_, err := app.AdminDailyLocationReward(ctx, &v1.AdminDailyLocationRewardRequest{})
+				return err
+			},
+			"daily_recommend_reward": func(ctx context.Context) error {
+				_, err := app.AdminDailyRecommendReward(ctx, &v1.AdminDailyRecommendRewardRequest{})
+				return err
+			},
+			"daily_balance_reward": func(ctx context.Context) error {
+				_, err := app.AdminDailyBalanceReward(ctx, &v1.AdminDailyBalanceRewardRequest{})
+				return err
+			},
+			"fee": func(ctx context.Context) error {
+				_, err := app.AdminFee(ctx, &v1.AdminFeeRequest{})
+				return err
+			},
+			"daily_fee": func(ctx context.Context) error {
+				_, err := app.AdminDailyFee(ctx, &v1.AdminDailyFeeRequest{})
+				return err
+			},
+			"check_recommend_area": func(ctx context.Context) error {
+				_, err := app.CheckAndInsertRecommendArea(ctx, &v1.CheckAndInsertRecommendAreaRequest{})
+				return err
+			},
+			"check_locations_recommend_user": func(ctx context.Context) error {
+				_, err := app.CheckAndInsertLocationsRecommendUser(ctx, &v1.CheckAndInsertLocationsRecommendUserRequest{})
+				return err
+			},
+			"check_user_area": func(ctx context.Context) error {
+				_, err := app.CheckAdminUserArea(ctx, &v1.CheckAdminUserAreaRequest{})
+				return err
+			},
+		},
+	}
+}
+
+// Start 启动所有配置了的任务
+func (s *JobServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	if nil == s.c {
+		return nil
+	}
+
+	for _, v := range s.c.Schedules {
+		job, ok := s.jobs[v.Name]
+		if !ok {
+			s.log.Errorf("job %s not found", v.Name)
+			continue
+		}
+
+		s.wg.Add(1)
+		if "" != v.At {
+			go s.runDaily(ctx, v.Name, v.At, job)
+		} else if nil != v.Interval && 0 < v.Interval.AsDuration() {
+			go s.runInterval(ctx, v.Name, v.Interval.AsDuration(), job)
+		} else {
+			s.wg.Done()
+			s.log.Errorf("job %s has no schedule", v.Name)
+		}
+	}
+
+	return nil
+}
+
+// Stop 等待执行中的任务结束
+func (s *JobServer) Stop(ctx context.Context) error {
+	if nil != s.cancel {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *JobServer) lockTtl() time.Duration {
+	if nil != s.c.LockTtl && 0 < s.c.LockTtl.AsDuration() {
+		return s.c.LockTtl.AsDuration()
+	}
+	return 10 * time.Minute
+}
+
+// lock 抢任务锁，持有期间按ttl的1/3续期，续期失败时取消返回的ctx，unlock只释放自己持有的锁
+func (s *JobServer) lock(ctx context.Context, name string) (context.Context, func(), bool) {
+	key := "job:lock:" + name
+	ttl := s.lockTtl()
+	ok, err := s.rdb.SetNX(ctx, key, s.id, ttl).Result()
+	if nil != err {
+		s.log.Errorf("job %s lock: %v", name, err)
+		return ctx, nil, false
+	}
+	if !ok { // 其他副本执行中
+		return ctx, nil, false
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			renewed, err := renewScript.Run(context.Background(), s.rdb, []string{key}, s.id, ttl.Milliseconds()).Int64()
+			if nil != err {
+				s.log.Errorf("job %s renew: %v", name, err)
+				continue
+			}
+			if 1 != renewed { // 锁已过期被其他副本拿走，停止执行
+				s.log.Errorf("job %s lock lost", name)
+				cancel()
+				return
+			}
+		}
+	}()
+
+	return jobCtx, func() {
+		close(done)
+		cancel()
+		if err := unlockScript.Run(context.Background(), s.rdb, []string{key}, s.id).Err(); nil != err {
+			s.log.Errorf("job %s unlock: %v", name, err)
+		}
+	}, true
+}
+
+// runInterval 每个间隔抢一次锁，抢到的副本执行，执行完释放
+func (s *JobServer) runInterval(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		jobCtx, unlock, ok := s.lock(ctx, name)
+		if !ok {
+			continue
+		}
+		_ = s.run(jobCtx, name, job)
+		unlock()
+	}
+}
+
+// runDaily 每天到点后抢任务锁执行，成功后才记录当天已执行，失败或中断的隔dailyRetry重试，重复执行由任务自身保证幂等
+func (s *JobServer) runDaily(ctx context.Context, name string, at string, job func(ctx context.Context) error) {
+	defer s.wg.Done()
+
+	atTime, err := time.Parse("15:04", at)
+	if nil != err {
+		s.log.Errorf("job %s at %s: %v", name, at, err)
+		return
+	}
+
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	var retryAt time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now().UTC().Add(8 * time.Hour)
+		if now.Hour()*60+now.Minute() < atTime.Hour()*60+atTime.Minute() || time.Now().Before(retryAt) {
+			continue
+		}
+
+		if err = s.runDailyOnce(ctx, name, now.Format("2006-01-02"), job); nil != err {
+			retryAt = time.Now().Add(dailyRetry)
+		}
+	}
+}
+
+// runDailyOnce 当天未执行成功时抢锁执行，成功后记录
+func (s *JobServer) runDailyOnce(ctx context.Context, name string, day string, job func(ctx context.Context) error) error {
+	key := "job:daily:" + name + ":" + day
+	if s.dailyDone(ctx, name, key) {
+		return nil
+	}
+
+	jobCtx, unlock, ok := s.lock(ctx, name)
+	if !ok {
+		return nil
+	}
+	defer unlock()
+	if s.dailyDone(ctx, name, key) { // 其他副本刚执行完
+		return nil
+	}
+
+	if err := s.run(jobCtx, name, job); nil != err {
+		return err
+	}
+	if err := s.rdb.Set(ctx, key, s.id, 48*time.Hour).Err(); nil != err {
+		s.log.Errorf("job %s done: %v", name, err)
+	}
+
+	return nil
+}
+
+// dailyDone 当天是否已执行成功，查询失败时按已执行处理，下次再查
+func (s *JobServer) dailyDone(ctx context.Context, name string, key string) bool {
+	n, err := s.rdb.Exists(ctx, key).Result()
+	if nil != err {
+		s.log.Errorf("job %s daily: %v", name, err)
+		return true
+	}
+	return 0 < n
+}
+
+func (s *JobServer) run(ctx context.Context, name string, job func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); nil != r {
+			s.log.Errorf("job %s panic: %v", name, r)
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	start := time.Now()
+	if err = job(ctx); nil != err {
+		s.log.Errorf("job %s: %v", name, err)
+		return err
+	}
+	s.log.Infof("job %s done in %s", name, time.Since(start))
+	return nil
+}
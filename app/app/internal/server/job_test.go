@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"testing"
+	"time"
+)
+
+func newTestJobServer(t *testing.T, mr *miniredis.Miniredis, id string, ttl time.Duration) *JobServer {
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return &JobServer{
+		c:   &conf.Job{LockTtl: durationpb.New(ttl)},
+		rdb: rdb,
+		id:  id,
+		log: log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func TestJobDailyRetryAfterFailure(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := newTestJobServer(t, mr, "a", time.Minute)
+	ctx := context.Background()
+
+	runs := 0
+	job := func(ctx context.Context) error {
+		runs++
+		if 1 == runs {
+			return errors.New("reward failed")
+		}
+		return nil
+	}
+
+	// 失败不记录当天已执行
+	if err := s.runDailyOnce(ctx, "daily", "2026-10-18", job); nil == err {
+		t.Fatal("want job error")
+	}
+	if mr.Exists("job:daily:daily:2026-10-18") {
+		t.Fatal("failed run marked done")
+	}
+
+	if err := s.runDailyOnce(ctx, "daily", "2026-10-18", job); nil != err {
+		t.Fatal(err)
+	}
+	if err := s.runDailyOnce(ctx, "daily", "2026-10-18", job); nil != err {
+		t.Fatal(err)
+	}
+	if 2 != runs {
+		t.Fatalf("runs %d, want 2", runs)
+	}
+
+	// 中途panic同样可以重试
+	if err := s.runDailyOnce(ctx, "daily", "2026-10-19", func(ctx context.Context) error { panic("crash") }); nil == err {
+		t.Fatal("want panic error")
+	}
+	if mr.Exists("job:daily:daily:2026-10-19") || mr.Exists("job:lock:daily") {
+		t.Fatal("panic run left keys")
+	}
+}
+
+func TestJobLockRenewed(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a := newTestJobServer(t, mr, "a", 300*time.Millisecond)
+	b := newTestJobServer(t, mr, "b", 300*time.Millisecond)
+	ctx := context.Background()
+
+	jobCtx, unlock, ok := a.lock(ctx, "withdraw")
+	if !ok {
+		t.Fatal("lock not acquired")
+	}
+
+	// 执行时间超过ttl，锁一直续期，其他副本抢不到
+	for i := 0; i < 10; i++ {
+		time.Sleep(100 * time.Millisecond)
+		mr.FastForward(100 * time.Millisecond)
+		if _, _, ok := b.lock(ctx, "withdraw"); ok {
+			t.Fatalf("lock taken by another instance after %d00ms", i+1)
+		}
+	}
+	if nil != jobCtx.Err() {
+		t.Fatal("job cancelled while holding the lock")
+	}
+	unlock()
+
+	_, unlockB, ok := b.lock(ctx, "withdraw")
+	if !ok {
+		t.Fatal("lock not released")
+	}
+	unlockB()
+}
+
+func TestJobLockLost(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a := newTestJobServer(t, mr, "a", 300*time.Millisecond)
+	ctx := context.Background()
+
+	jobCtx, unlock, ok := a.lock(ctx, "sweep")
+	if !ok {
+		t.Fatal("lock not acquired")
+	}
+	defer unlock()
+
+	// 锁被其他副本拿走后取消执行，释放时不删别人的锁
+	mr.Set("job:lock:sweep", "b")
+	select {
+	case <-jobCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("job not cancelled after losing the lock")
+	}
+	if v, _ := mr.Get("job:lock:sweep"); "b" != v {
+		t.Fatalf("lock %s, want b", v)
+	}
+}
@@ -2,29 +2,16 @@ package service
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"dhb/app/app/internal/pkg/middleware/auth"
-	"encoding/json"
-	"fmt"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/crypto/sha3"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	jwt2 "github.com/golang-jwt/jwt/v4"
-	"io"
-	"math/big"
-	"net/url"
-	"strconv"
+	"strings"
 
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
-	"io/ioutil"
-	"net/http"
 	"time"
 )
 
@@ -32,26 +19,57 @@ import (
 type AppService struct {
 	v1.UnimplementedAppServer
 
-	uuc *biz.UserUseCase
-	ruc *biz.RecordUseCase
-	log *log.Helper
-	ca  *conf.Auth
+	uuc    *biz.UserUseCase
+	ruc    *biz.RecordUseCase
+	signer biz.Signer
+	log    *log.Helper
+	ca     *conf.Auth
 }
 
 // NewAppService new a service.
-func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, logger log.Logger, ca *conf.Auth) *AppService {
-	return &AppService{uuc: uuc, ruc: ruc, log: log.NewHelper(logger), ca: ca}
+func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, signer biz.Signer, logger log.Logger, ca *conf.Auth) *AppService {
+	return &AppService{uuc: uuc, ruc: ruc, signer: signer, log: log.NewHelper(logger), ca: ca}
+}
+
+func (a *AppService) siweOption() *biz.SiweOption {
+	o := &biz.SiweOption{
+		Domain:  a.ca.SiweDomain,
+		Uri:     a.ca.SiweUri,
+		ChainId: a.ca.ChainId,
+	}
+	if nil != a.ca.NonceTtl {
+		o.NonceTtl = a.ca.NonceTtl.AsDuration()
+	}
+	return o
+}
+
+// EthAuthorizeNonce 获取登录签名消息
+func (a *AppService) EthAuthorizeNonce(ctx context.Context, req *v1.EthAuthorizeNonceRequest) (*v1.EthAuthorizeNonceReply, error) {
+	nonce, message, err := a.uuc.CreateSiweMessage(ctx, req.Address, a.siweOption())
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.EthAuthorizeNonceReply{
+		Nonce:   nonce,
+		Message: message,
+	}, nil
 }
 
 // EthAuthorize ethAuthorize.
 func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeRequest) (*v1.EthAuthorizeReply, error) {
-	// TODO 有效的参数验证
+	if nil == req.SendBody {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "参数错误")
+	}
 	userAddress := req.SendBody.Address // 以太坊账户
 	if "" == userAddress || 20 > len(userAddress) {
 		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
 	}
 
-	// TODO 验证签名
+	// 验证签名，签名消息来自EthAuthorizeNonce
+	if err := a.uuc.VerifySiweMessage(ctx, userAddress, req.SendBody.Message, req.SendBody.Signature, a.siweOption()); nil != err {
+		return nil, err
+	}
 
 	// 根据地址查询用户，不存在时则创建
 	user, err := a.uuc.GetExistUserByAddressOrCreate(ctx, &biz.User{
@@ -63,7 +81,7 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 
 	claims := auth.CustomClaims{
 		UserId:   user.ID,
-		UserType: "user",
+		UserType: auth.UserTypeUser,
 		StandardClaims: jwt2.StandardClaims{
 			NotBefore: time.Now().Unix(),              // 签名的生效时间
 			ExpiresAt: time.Now().Unix() + 60*60*24*7, // 7天过期
@@ -83,184 +101,11 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 
 // Deposit deposit.
 func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
-
-	var (
-		depositUsdtResult map[string]*eth
-		//depositDhbResult      map[string]*eth
-		//tmpDepositDhbResult   map[string]*eth
-		//userDepositDhbResult  map[string]map[string]*eth
-		notExistDepositResult []*biz.EthUserRecord
-		existEthUserRecords   map[string]*biz.EthUserRecord
-		depositUsers          map[string]*biz.User
-		fromAccount           []string
-		hashKeys              []string
-		//lock                  bool
-		err error
-		//configs               []*biz.Config
-		//level1Dhb             string
-		//level2Dhb             string
-		//level3Dhb             string
-		globalLock *biz.GlobalLock
-	)
-
 	end := time.Now().UTC().Add(20 * time.Second)
-
-	// 配置
-	//configs, err = a.uuc.GetDhbConfig(ctx)
-	//if nil != configs {
-	//	for _, vConfig := range configs {
-	//		if "level1Dhb" == vConfig.KeyName {
-	//			level1Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level2Dhb" == vConfig.KeyName {
-	//			level2Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level3Dhb" == vConfig.KeyName {
-	//			level3Dhb = vConfig.Value + "0000000000000000"
-	//		}
-	//	}
-	//}
-
-	//if lock, _ = a.ruc.LockEthUserRecordHandle(ctx); !lock { // 上全局锁简单，防止资源更新抢占
-	//	return &v1.DepositReply{}, nil
-	//}
-
-	//depositUsdtResult = make(map[string]*eth, 0)
-	// 每次一共最多查2000条，所以注意好外层调用的定时查询的时间设置，当然都可以重新定义，
-	// 在功能上调用者查询两种币的交易记录，每次都要把数据覆盖查询，是一个较大范围的查找防止遗漏数据，范围最起码要大于实际这段时间的入单量，不能边界查询容易掉单，这样的实现是因为简单
-	for i := 1; i <= 5; i++ {
-
-		// 获取系统锁
-		globalLock, err = a.ruc.GetGlobalLock(ctx)
-		if 1 != globalLock.Status {
-			break
-		}
-
-		//depositUsdtResult, err = requestEthDepositResult(200, int64(i), "0x55d398326f99059fF775485246999027B3197955")
-		depositUsdtResult, err = requestEthDepositResult(200, int64(i), "0x55d398326f99059fF775485246999027B3197955")
-		if nil != err {
-			break
-		}
-
-		now := time.Now().UTC()
-		fmt.Println(now, end)
-		if end.Before(now) {
-			break
-		}
-		// 辅助查询
-		//depositDhbResult, err = requestEthDepositResult(200, int64(i), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//tmpDepositDhbResult, err = requestEthDepositResult(100, int64(i+1), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//for kTmpDepositDhbResult, v := range tmpDepositDhbResult {
-		//	if _, ok := tmpDepositDhbResult[kTmpDepositDhbResult]; !ok {
-		//		depositDhbResult[kTmpDepositDhbResult] = v
-		//	}
-		//}
-		if 0 >= len(depositUsdtResult) {
-			break
-		}
-
-		for hashKey, vDepositResult := range depositUsdtResult { // 主查询
-			hashKeys = append(hashKeys, hashKey)
-			fromAccount = append(fromAccount, vDepositResult.From)
-		}
-		//userDepositDhbResult = make(map[string]map[string]*eth, 0) // 辅助数据
-		//for k, v := range depositDhbResult {
-		//	hashKeys = append(hashKeys, k)
-		//	fromAccount = append(fromAccount, v.From)
-		//	if _, ok := userDepositDhbResult[v.From]; !ok {
-		//		userDepositDhbResult[v.From] = make(map[string]*eth, 0)
-		//	}
-		//	userDepositDhbResult[v.From][k] = v
-		//}
-
-		depositUsers, err = a.uuc.GetUserByAddress(ctx, fromAccount...)
-		if nil != depositUsers {
-			existEthUserRecords, err = a.ruc.GetEthUserRecordByTxHash(ctx, hashKeys...)
-			// 统计开始
-			notExistDepositResult = make([]*biz.EthUserRecord, 0)
-			for _, vDepositUsdtResult := range depositUsdtResult { // 主查usdt
-				if _, ok := existEthUserRecords[vDepositUsdtResult.Hash]; ok { // 记录已存在
-					continue
-				}
-				if _, ok := depositUsers[vDepositUsdtResult.From]; !ok { // 用户不存在
-					continue
-				}
-				//if _, ok := userDepositDhbResult[vDepositUsdtResult.From]; !ok { // 没有dhb的充值记录
-				//	continue
-				//}
-				//var (
-				//	tmpDhbHash, tmpDhbHashValue string
-				//)
-
-				//tmpPass := false
-				//for _, vUserDepositDhbResult := range userDepositDhbResult[vDepositUsdtResult.From] { // 充值数额类型匹配
-				//	if _, ok := existEthUserRecords[vUserDepositDhbResult.Hash]; ok { // 记录已存在
-				//		continue
-				//	}
-				//
-				//	if "10000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else if "30000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else if "50000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else {
-				//		continue
-				//	}
-				//
-				//	tmpDhbHash = vUserDepositDhbResult.Hash
-				//	tmpDhbHashValue = vUserDepositDhbResult.Value
-				//}
-				//if !tmpPass {
-				//	continue
-				//}
-
-				// 最少百位以上
-				lenValue := len(vDepositUsdtResult.Value)
-				if 20 > lenValue { // 0.1
-					continue
-				}
-				// 去掉8个尾数0作为系统金额
-				tmpValue, _ := strconv.ParseInt(vDepositUsdtResult.Value[0:lenValue-8], 10, 64)
-				if 0 == tmpValue {
-					continue
-				}
-				//fmt.Println(vDepositUsdtResult.Value, tmpValue)
-				tmpValue = tmpValue * 10 // 地址分，精度目前只识别到这里，如果有人
-				//fmt.Println(tmpValue)
-				if int64(1000000000000) > tmpValue { // 目前0.1表示
-					continue
-				}
-
-				notExistDepositResult = append(notExistDepositResult, &biz.EthUserRecord{ // 两种币的记录
-					UserId:    depositUsers[vDepositUsdtResult.From].ID,
-					Hash:      vDepositUsdtResult.Hash,
-					Status:    "success",
-					Type:      "deposit",
-					Amount:    strconv.FormatInt(tmpValue, 10) + "00000000",
-					RelAmount: tmpValue,
-					CoinType:  "USDT",
-				})
-
-				//&biz.EthUserRecord{
-				//	UserId:   depositUsers[vDepositUsdtResult.From].ID,
-				//	Hash:     tmpDhbHash,
-				//	Status:   "success",
-				//	Type:     "deposit",
-				//	Amount:   tmpDhbHashValue,
-				//	CoinType: "DHB",
-				//}
-			}
-
-			_, err = a.ruc.EthUserRecordHandle(ctx, notExistDepositResult...)
-			if nil != err {
-				fmt.Println(err)
-			}
-
-		}
-
-		//time.Sleep(2 * time.Second)
+	if err := a.ruc.DepositHandle(ctx, end); nil != err {
+		a.log.Error(err)
 	}
 
-	//_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
 	return &v1.DepositReply{}, nil
 }
 
@@ -268,256 +113,47 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 func (a *AppService) Deposit2(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
 	time.Sleep(30 * time.Second)
 	end := time.Now().UTC().Add(20 * time.Second)
-	var (
-		depositUsdtResult map[string]*eth
-		//depositDhbResult      map[string]*eth
-		//tmpDepositDhbResult   map[string]*eth
-		//userDepositDhbResult  map[string]map[string]*eth
-		notExistDepositResult []*biz.EthUserRecord
-		existEthUserRecords   map[string]*biz.EthUserRecord
-		depositUsers          map[string]*biz.User
-		fromAccount           []string
-		hashKeys              []string
-		//lock                  bool
-		err error
-		//configs               []*biz.Config
-		//level1Dhb             string
-		//level2Dhb             string
-		//level3Dhb             string
-		globalLock *biz.GlobalLock
-	)
-
-	// 配置
-	//configs, err = a.uuc.GetDhbConfig(ctx)
-	//if nil != configs {
-	//	for _, vConfig := range configs {
-	//		if "level1Dhb" == vConfig.KeyName {
-	//			level1Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level2Dhb" == vConfig.KeyName {
-	//			level2Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level3Dhb" == vConfig.KeyName {
-	//			level3Dhb = vConfig.Value + "0000000000000000"
-	//		}
-	//	}
-	//}
-
-	//if lock, _ = a.ruc.LockEthUserRecordHandle(ctx); !lock { // 上全局锁简单，防止资源更新抢占
-	//	return &v1.DepositReply{}, nil
-	//}
-
-	//depositUsdtResult = make(map[string]*eth, 0)
-	// 每次一共最多查2000条，所以注意好外层调用的定时查询的时间设置，当然都可以重新定义，
-	// 在功能上调用者查询两种币的交易记录，每次都要把数据覆盖查询，是一个较大范围的查找防止遗漏数据，范围最起码要大于实际这段时间的入单量，不能边界查询容易掉单，这样的实现是因为简单
-	for i := 1; i <= 5; i++ {
-
-		// 获取系统锁
-		globalLock, err = a.ruc.GetGlobalLock(ctx)
-		if 1 != globalLock.Status {
-			break
-		}
-
-		//depositUsdtResult, err = requestEthDepositResult(200, int64(i), "0x55d398326f99059fF775485246999027B3197955")
-		depositUsdtResult, err = requestEthDepositResult(200, int64(i), "0x55d398326f99059fF775485246999027B3197955")
-		if nil != err {
-			break
-		}
-
-		now := time.Now().UTC()
-		fmt.Println(now, end)
-		if end.Before(now) {
-			break
-		}
-
-		// 辅助查询
-		//depositDhbResult, err = requestEthDepositResult(200, int64(i), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//tmpDepositDhbResult, err = requestEthDepositResult(100, int64(i+1), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//for kTmpDepositDhbResult, v := range tmpDepositDhbResult {
-		//	if _, ok := tmpDepositDhbResult[kTmpDepositDhbResult]; !ok {
-		//		depositDhbResult[kTmpDepositDhbResult] = v
-		//	}
-		//}
-		if 0 >= len(depositUsdtResult) {
-			break
-		}
-
-		for hashKey, vDepositResult := range depositUsdtResult { // 主查询
-			hashKeys = append(hashKeys, hashKey)
-			fromAccount = append(fromAccount, vDepositResult.From)
-		}
-		//userDepositDhbResult = make(map[string]map[string]*eth, 0) // 辅助数据
-		//for k, v := range depositDhbResult {
-		//	hashKeys = append(hashKeys, k)
-		//	fromAccount = append(fromAccount, v.From)
-		//	if _, ok := userDepositDhbResult[v.From]; !ok {
-		//		userDepositDhbResult[v.From] = make(map[string]*eth, 0)
-		//	}
-		//	userDepositDhbResult[v.From][k] = v
-		//}
-
-		depositUsers, err = a.uuc.GetUserByAddress(ctx, fromAccount...)
-		if nil != depositUsers {
-			existEthUserRecords, err = a.ruc.GetEthUserRecordByTxHash(ctx, hashKeys...)
-			// 统计开始
-			notExistDepositResult = make([]*biz.EthUserRecord, 0)
-			for _, vDepositUsdtResult := range depositUsdtResult { // 主查usdt
-				if _, ok := existEthUserRecords[vDepositUsdtResult.Hash]; ok { // 记录已存在
-					continue
-				}
-				if _, ok := depositUsers[vDepositUsdtResult.From]; !ok { // 用户不存在
-					continue
-				}
-				//if _, ok := userDepositDhbResult[vDepositUsdtResult.From]; !ok { // 没有dhb的充值记录
-				//	continue
-				//}
-				//var (
-				//	tmpDhbHash, tmpDhbHashValue string
-				//)
-
-				//tmpPass := false
-				//for _, vUserDepositDhbResult := range userDepositDhbResult[vDepositUsdtResult.From] { // 充值数额类型匹配
-				//	if _, ok := existEthUserRecords[vUserDepositDhbResult.Hash]; ok { // 记录已存在
-				//		continue
-				//	}
-				//
-				//	if "10000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else if "30000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else if "50000000000000000" == vDepositUsdtResult.Value {
-				//		tmpPass = true
-				//	} else {
-				//		continue
-				//	}
-				//
-				//	tmpDhbHash = vUserDepositDhbResult.Hash
-				//	tmpDhbHashValue = vUserDepositDhbResult.Value
-				//}
-				//if !tmpPass {
-				//	continue
-				//}
-
-				// 最少百位以上
-				lenValue := len(vDepositUsdtResult.Value)
-				if 20 > lenValue { // 0.1
-					continue
-				}
-				// 去掉8个尾数0作为系统金额
-				tmpValue, _ := strconv.ParseInt(vDepositUsdtResult.Value[0:lenValue-8], 10, 64)
-				if 0 == tmpValue {
-					continue
-				}
-				//fmt.Println(vDepositUsdtResult.Value, tmpValue)
-				tmpValue = tmpValue * 10 // 4个地址分，精度目前只识别到这里，如果有人
-				//fmt.Println(tmpValue)
-				if int64(1000000000000) > tmpValue { // 目前0.1表示
-					continue
-				}
-
-				notExistDepositResult = append(notExistDepositResult, &biz.EthUserRecord{ // 两种币的记录
-					UserId:    depositUsers[vDepositUsdtResult.From].ID,
-					Hash:      vDepositUsdtResult.Hash,
-					Status:    "success",
-					Type:      "deposit",
-					Amount:    strconv.FormatInt(tmpValue, 10) + "00000000",
-					RelAmount: tmpValue,
-					CoinType:  "USDT",
-				})
-
-				//&biz.EthUserRecord{
-				//	UserId:   depositUsers[vDepositUsdtResult.From].ID,
-				//	Hash:     tmpDhbHash,
-				//	Status:   "success",
-				//	Type:     "deposit",
-				//	Amount:   tmpDhbHashValue,
-				//	CoinType: "DHB",
-				//}
-			}
-
-			_, err = a.ruc.EthUserRecordHandle(ctx, notExistDepositResult...)
-			if nil != err {
-				fmt.Println(err)
-			}
-
-		}
-
-		//time.Sleep(2 * time.Second)
+	if err := a.ruc.DepositHandle(ctx, end); nil != err {
+		a.log.Error(err)
 	}
 
-	//_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
 	return &v1.DepositReply{}, nil
 }
 
-type eth struct {
-	Value       string
-	Hash        string
-	TokenSymbol string
-	From        string
-	To          string
-}
-
-func requestEthDepositResult(offset int64, page int64, contractAddress string) (map[string]*eth, error) {
-	//apiUrl := "https://api-testnet.bscscan.com/api"
-	apiUrl := "https://api.bscscan.com/api"
-	// URL param
-	data := url.Values{}
-	data.Set("module", "account")
-	data.Set("action", "tokentx")
-	data.Set("contractaddress", contractAddress)
-	data.Set("apikey", "CRCSHR2G3WXB1MET3BNA7ZQKQVSNXFYX18")
-	data.Set("address", "0x8aaccab66c923ae3a3ff96d23c6ac73aa365a858")
-	data.Set("sort", "desc")
-	data.Set("offset", strconv.FormatInt(offset, 10))
-	data.Set("page", strconv.FormatInt(page, 10))
-
-	u, err := url.ParseRequestURI(apiUrl)
-	if err != nil {
-		return nil, err
-	}
-	u.RawQuery = data.Encode() // URL encode
-	client := http.Client{
-		Timeout: 10 * time.Second,
-	}
-	fmt.Println(u.String())
-
-	resp, err := client.Get(u.String())
-	if err != nil {
-		return nil, err
-	}
-
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-
+// UserInfo userInfo.
+func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1.UserInfoReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
 		}
-	}(resp.Body)
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var i struct {
-		Message string `json:"message"`
-		Result  []*eth `json:"Result"`
-	}
-	err = json.Unmarshal(b, &i)
-	if err != nil {
-		return nil, err
+		userId = int64(c["UserId"].(float64))
 	}
 
-	//fmt.Println(string(b))
+	return a.uuc.UserInfo(ctx, &biz.User{
+		ID: userId,
+	})
+}
 
-	res := make(map[string]*eth, 0)
-	for _, v := range i.Result {
-		if "0x8aaccab66c923ae3a3ff96d23c6ac73aa365a858" == v.To { // 接收者
-			res[v.Hash] = v
+func (a *AppService) ProductList(ctx context.Context, req *v1.ProductListRequest) (*v1.ProductListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
 		}
+		userId = int64(c["UserId"].(float64))
 	}
 
-	return res, err
+	return a.uuc.ProductList(ctx, req, &biz.User{
+		ID: userId,
+	})
 }
 
-// UserInfo userInfo.
-func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1.UserInfoReply, error) {
+func (a *AppService) DepositProduct(ctx context.Context, req *v1.DepositProductRequest) (*v1.DepositProductReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var userId int64
 	if claims, ok := jwt.FromContext(ctx); ok {
@@ -528,7 +164,7 @@ func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.UserInfo(ctx, &biz.User{
+	return a.uuc.DepositProduct(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -561,7 +197,7 @@ func (a *AppService) RecommendRewardList(ctx context.Context, req *v1.RecommendR
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.RecommendRewardList(ctx, &biz.User{
+	return a.uuc.RecommendRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -577,7 +213,7 @@ func (a *AppService) FeeRewardList(ctx context.Context, req *v1.FeeRewardListReq
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.FeeRewardList(ctx, &biz.User{
+	return a.uuc.FeeRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -643,6 +279,10 @@ func (a *AppService) AdminWithdrawPass(ctx context.Context, req *v1.AdminWithdra
 	return a.uuc.AdminWithdrawPass(ctx, req)
 }
 
+func (a *AppService) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	return a.uuc.AdminWithdrawReject(ctx, req)
+}
+
 func (a *AppService) CheckAdminUserArea(ctx context.Context, req *v1.CheckAdminUserAreaRequest) (*v1.CheckAdminUserAreaReply, error) {
 	return a.uuc.CheckAdminUserArea(ctx, req)
 }
@@ -759,28 +399,93 @@ func (a *AppService) LockSystem(ctx context.Context, req *v1.LockSystemRequest)
 	return a.ruc.LockSystem(ctx, req)
 }
 
+func (a *AppService) AdminWithdrawRefund(ctx context.Context, req *v1.AdminWithdrawRefundRequest) (*v1.AdminWithdrawRefundReply, error) {
+	return a.uuc.AdminWithdrawRefund(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawTrack(ctx context.Context, req *v1.AdminWithdrawTrackRequest) (*v1.AdminWithdrawTrackReply, error) {
+	return a.uuc.AdminWithdrawTrack(ctx, req)
+}
+
+func (a *AppService) AdminWalletTxCheck(ctx context.Context, req *v1.AdminWalletTxCheckRequest) (*v1.AdminWalletTxCheckReply, error) {
+	return a.uuc.AdminWalletTxCheck(ctx, req)
+}
+
+func (a *AppService) AdminWalletTxReplace(ctx context.Context, req *v1.AdminWalletTxReplaceRequest) (*v1.AdminWalletTxReplaceReply, error) {
+	return a.uuc.AdminWalletTxReplace(ctx, req)
+}
+
+func (a *AppService) AdminSweep(ctx context.Context, req *v1.AdminSweepRequest) (*v1.AdminSweepReply, error) {
+	return a.uuc.AdminSweep(ctx, req)
+}
+
+func (a *AppService) AdminDepositReorgCheck(ctx context.Context, req *v1.AdminDepositReorgCheckRequest) (*v1.AdminDepositReorgCheckReply, error) {
+	return a.ruc.AdminDepositReorgCheck(ctx, req)
+}
+
+func (a *AppService) AdminDepositReorgList(ctx context.Context, req *v1.AdminDepositReorgListRequest) (*v1.AdminDepositReorgListReply, error) {
+	return a.ruc.AdminDepositReorgList(ctx, req)
+}
+
+func (a *AppService) AdminDepositReorgResolve(ctx context.Context, req *v1.AdminDepositReorgResolveRequest) (*v1.AdminDepositReorgResolveReply, error) {
+	return a.ruc.AdminDepositReorgResolve(ctx, req)
+}
+
+func (a *AppService) AdminDepositLookup(ctx context.Context, req *v1.AdminDepositLookupRequest) (*v1.AdminDepositLookupReply, error) {
+	return a.ruc.AdminDepositLookup(ctx, req)
+}
+
+func (a *AppService) AdminDepositCredit(ctx context.Context, req *v1.AdminDepositCreditRequest) (*v1.AdminDepositCreditReply, error) {
+	return a.ruc.AdminDepositCredit(ctx, req)
+}
+
+func (a *AppService) AdminInboundTransferList(ctx context.Context, req *v1.AdminInboundTransferListRequest) (*v1.AdminInboundTransferListReply, error) {
+	return a.ruc.AdminInboundTransferList(ctx, req)
+}
+
+func (a *AppService) AdminInboundTransferResolve(ctx context.Context, req *v1.AdminInboundTransferResolveRequest) (*v1.AdminInboundTransferResolveReply, error) {
+	return a.ruc.AdminInboundTransferResolve(ctx, req)
+}
+
+func (a *AppService) AdminProductList(ctx context.Context, req *v1.AdminProductListRequest) (*v1.AdminProductListReply, error) {
+	return a.uuc.AdminProductList(ctx, req)
+}
+
+func (a *AppService) AdminProductSave(ctx context.Context, req *v1.AdminProductSaveRequest) (*v1.AdminProductSaveReply, error) {
+	return a.uuc.AdminProductSave(ctx, req)
+}
+
+func (a *AppService) AdminTokenList(ctx context.Context, req *v1.AdminTokenListRequest) (*v1.AdminTokenListReply, error) {
+	return a.uuc.AdminTokenList(ctx, req)
+}
+
+func (a *AppService) AdminTokenSave(ctx context.Context, req *v1.AdminTokenSaveRequest) (*v1.AdminTokenSaveReply, error) {
+	return a.uuc.AdminTokenSave(ctx, req)
+}
+
 func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdrawEthRequest) (*v1.AdminWithdrawEthReply, error) {
 	var (
-		withdraw     *biz.Withdraw
-		userIds      []int64
-		userIdsMap   map[int64]int64
-		users        map[int64]*biz.User
-		tokenAddress string
-		globalLock   *biz.GlobalLock
-		err          error
+		withdraw   *biz.Withdraw
+		userIds    []int64
+		userIdsMap map[int64]int64
+		users      map[int64]*biz.User
+		globalLock *biz.GlobalLock
+		skipIds    []int64 // 本轮处理不了的提现，下次定时任务再处理
+		err        error
 	)
 
 	for {
 		globalLock, err = a.ruc.GetGlobalLock(ctx)
-		if 1 != globalLock.Status {
+		if nil != err || 1 != globalLock.Status {
 			break
 		}
 
-		withdraw, err = a.uuc.GetWithdrawPassOrRewardedFirst(ctx)
+		withdraw, err = a.uuc.GetWithdrawPassOrRewardedFirst(ctx, skipIds...)
 		if nil == withdraw {
 			break
 		}
 
+		userIds = make([]int64, 0)
 		userIdsMap = make(map[int64]int64, 0)
 		//for _, vWithdraws := range withdraws {
 		//	userIdsMap[vWithdraws.UserId] = vWithdraws.UserId
@@ -796,198 +501,51 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 		}
 
 		if _, ok := users[withdraw.UserId]; !ok {
+			a.log.Errorf("withdraw %d user %d not found", withdraw.ID, withdraw.UserId)
+			skipIds = append(skipIds, withdraw.ID)
 			continue
 		}
 
-		if "dhb" == withdraw.Type {
-			tokenAddress = "0x6504631df9F6FF397b0ec442FB80685a7B1688d4"
-		} else if "usdt" == withdraw.Type {
-			//tokenAddress = "0x337610d27c682E347C9cD60BD4b3b107C9d34dDd"
-			tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		} else {
+		err = a.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
+		if nil != err { // 已被其他实例处理或状态已变
+			a.log.Errorf("withdraw %d doing: %v", withdraw.ID, err)
+			skipIds = append(skipIds, withdraw.ID)
 			continue
 		}
 
-		_, err = a.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
-		if nil != err {
-			continue
-		}
-
-		withDrawAmount := strconv.FormatInt(withdraw.RelAmount, 10) + "00000000" // 补八个0.系统基础1是10个0
-
+		success := false
 		for i := 0; i < 3; i++ {
-			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, _, err = toToken("", users[withdraw.UserId].Address, withDrawAmount, tokenAddress)
-			fmt.Println(3333, err)
+			// 每次重试复用同一个nonce，不会重复打款
+			_, err = a.uuc.WithdrawTransfer(ctx, withdraw, users[withdraw.UserId].Address) // 代币和精度从token表读取
 			if err == nil {
-				_, err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
+				success = true
+				err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
 				//time.Sleep(3 * time.Second)
 				break
-			} else if "insufficient funds for gas * price + value" == err.Error() {
-				_, _, err = toBnB("", "", 400000000000000000)
-				if nil != err {
-					fmt.Println(5555, err)
-					continue
-				}
-				time.Sleep(7 * time.Second)
-			} else {
-				time.Sleep(3 * time.Second)
 			}
-		}
 
-		// 清空bnb
-		//for j := 0; j < 3; j++ {
-		//	banBalance := BnbBalance("0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f")
-		//
-		//	tmpAmount, _ := strconv.ParseInt(banBalance, 10, 64)
-		//	fmt.Println(22222, tmpAmount)
-		//	tmpAmount -= 4000000000000000
-		//	fmt.Println(22222, banBalance, tmpAmount)
-		//
-		//	if 0 < tmpAmount {
-		//		//_, _, err = toBnB("0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f", user.ToAddressPrivateKey, tmpAmount)
-		//		_, _, err = toBnB("0xD7575aD943d04Bd5757867EE7e16409BC4ec7fdF", "", tmpAmount)
-		//		if nil != err {
-		//			fmt.Println(4444, err)
-		//			continue
-		//		}
-		//		time.Sleep(3 * time.Second)
-		//	}
-		//}
+			a.log.Errorf("withdraw %d transfer: %v", withdraw.ID, err)
+			if strings.Contains(err.Error(), "insufficient funds") {
+				a.log.Errorf("出款钱包%s手续费不足", a.signer.Address().Hex())
+				break
+			}
+			time.Sleep(3 * time.Second)
+		}
 
+		if !success { // 重试后仍失败，等待重新审核或退回
+			remark := ""
+			if nil != err {
+				remark = err.Error()
+			}
+			if 255 < len(remark) {
+				remark = remark[:255]
+			}
+			err = a.uuc.UpdateWithdrawFailed(ctx, withdraw.ID, remark)
+			if nil != err {
+				a.log.Errorf("withdraw %d failed: %v", withdraw.ID, err)
+			}
+		}
 	}
 
 	return &v1.AdminWithdrawEthReply{}, nil
 }
-
-func toBnB(toAccount string, fromPrivateKey string, toAmount int64) (bool, string, error) {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return false, "", err
-	}
-
-	privateKey, err := crypto.HexToECDSA(fromPrivateKey)
-	if err != nil {
-		return false, "", err
-	}
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return false, "", err
-	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		return false, "", err
-	}
-
-	value := big.NewInt(toAmount) // in wei (1 eth) 最低0.03bnb才能转账
-	fmt.Println(value)
-	gasLimit := uint64(210000) // in units
-	gasPrice, err := client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-	toAddress := common.HexToAddress(toAccount)
-	var data []byte
-	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, data)
-	chainID, err := client.NetworkID(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
-	if err != nil {
-		return false, "", err
-	}
-	err = client.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		return false, "", err
-	}
-	return true, signedTx.Hash().Hex(), nil
-}
-
-func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string) (bool, string, error) {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return false, "", err
-	}
-	// 转token
-	privateKey, err := crypto.HexToECDSA(userPrivateKey)
-	if err != nil {
-		return false, "", err
-	}
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return false, "", err
-	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		return false, "", err
-	}
-	value := big.NewInt(0) // in wei (0 eth)
-	gasPrice, err := client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-	toAddress := common.HexToAddress(toAccount)
-	// 0x337610d27c682E347C9cD60BD4b3b107C9d34dDd
-	// 0x55d398326f99059fF775485246999027B3197955
-	// tokenAddress := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
-	// tokenAddress := common.HexToAddress("0x337610d27c682E347C9cD60BD4b3b107C9d34dDd")
-	tokenAddress := common.HexToAddress(withdrawTokenAddress)
-	transferFnSignature := []byte("transfer(address,uint256)")
-	hash := sha3.NewKeccak256()
-	hash.Write(transferFnSignature)
-	methodID := hash.Sum(nil)[:4]
-
-	paddedAddress := common.LeftPadBytes(toAddress.Bytes(), 32)
-
-	amount := new(big.Int)
-
-	amount.SetString(withdrawAmount, 10) // 提现的金额恢复
-	paddedAmount := common.LeftPadBytes(amount.Bytes(), 32)
-
-	var data []byte
-	data = append(data, methodID...)
-	data = append(data, paddedAddress...)
-	data = append(data, paddedAmount...)
-
-	tx := types.NewTransaction(nonce, tokenAddress, value, 30000000, gasPrice, data)
-
-	chainID, err := client.NetworkID(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
-	if err != nil {
-		return false, "", err
-	}
-
-	err = client.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		return false, "", err
-	}
-	fmt.Println(signedTx.Hash().Hex())
-	return true, signedTx.Hash().Hex(), nil
-}
-
-func BnbBalance(bnbAccount string) string {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	account := common.HexToAddress(bnbAccount)
-	balance, err := client.BalanceAt(context.Background(), account, nil)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	return balance.String()
-}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+)
+
+// SignerService cmd/signer 的签名服务
+type SignerService struct {
+	v1.UnimplementedSignerServer
+
+	signer      biz.Signer
+	sweepSigner biz.SweepSigner
+	policy      *biz.SignPolicy
+	log         *log.Helper
+}
+
+// NewSignerService new a signer service.
+func NewSignerService(signer biz.Signer, sweepSigner biz.SweepSigner, policy *biz.SignPolicy, logger log.Logger) *SignerService {
+	return &SignerService{signer: signer, sweepSigner: sweepSigner, policy: policy, log: log.NewHelper(logger)}
+}
+
+func (s *SignerService) SignerAddress(ctx context.Context, req *v1.SignerAddressRequest) (*v1.SignerAddressReply, error) {
+	return &v1.SignerAddressReply{Address: s.signer.Address().Hex()}, nil
+}
+
+func (s *SignerService) SignTx(ctx context.Context, req *v1.SignTxRequest) (*v1.SignTxReply, error) {
+	var tx types.Transaction
+	if err := rlp.DecodeBytes(req.Tx, &tx); nil != err {
+		return nil, errors.New(400, "SIGN_TX_ERROR", "交易解析失败")
+	}
+
+	chainId := big.NewInt(req.ChainId)
+	if err := s.policy.Allow(ctx, &tx, chainId, req.DepositIndex); nil != err {
+		s.log.Errorf("sign tx %s rejected: %v", tx.Hash().Hex(), err)
+		return nil, err
+	}
+
+	signedTx, err := s.signer.SignTx(ctx, &tx, chainId)
+	if nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+
+	b, err := rlp.EncodeToBytes(signedTx)
+	if nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+
+	return &v1.SignTxReply{Tx: b, Hash: signedTx.Hash().Hex()}, nil
+}
+
+func (s *SignerService) SignSweepTx(ctx context.Context, req *v1.SignSweepTxRequest) (*v1.SignTxReply, error) {
+	var tx types.Transaction
+	if err := rlp.DecodeBytes(req.Tx, &tx); nil != err {
+		return nil, errors.New(400, "SIGN_TX_ERROR", "交易解析失败")
+	}
+
+	chainId := big.NewInt(req.ChainId)
+	if err := s.policy.AllowSweep(&tx, chainId); nil != err {
+		s.log.Errorf("sign sweep tx %d %s rejected: %v", req.Index, tx.Hash().Hex(), err)
+		return nil, err
+	}
+
+	signedTx, err := s.sweepSigner.SignSweepTx(ctx, req.Index, &tx, chainId)
+	if nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+
+	b, err := rlp.EncodeToBytes(signedTx)
+	if nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+
+	return &v1.SignTxReply{Tx: b, Hash: signedTx.Hash().Hex()}, nil
+}
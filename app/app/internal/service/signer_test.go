@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/pkg/middleware/auth"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/tyler-smith/go-bip32"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testSignerToken = "test-signer-token"
+
+var (
+	testChainId = big.NewInt(56)
+	testToken   = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	testUser    = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testCold    = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testOther   = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+// fakeSignPolicyRepo 内存实现，多个签名服务共用一个即模拟重启后读库
+type fakeSignPolicyRepo struct {
+	mu     sync.Mutex
+	nextId int64
+	usages map[string]*biz.SignUsage
+	users  map[string]bool
+}
+
+func newFakeSignPolicyRepo(users ...common.Address) *fakeSignPolicyRepo {
+	r := &fakeSignPolicyRepo{usages: make(map[string]*biz.SignUsage, 0), users: make(map[string]bool, 0)}
+	for _, v := range users {
+		r.users[strings.ToLower(v.Hex())] = true
+	}
+	return r
+}
+
+func (r *fakeSignPolicyRepo) GetSignUsage(ctx context.Context, wallet string, nonce int64, token string) (*biz.SignUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.usages[fmt.Sprintf("%s-%d-%s", wallet, nonce, token)]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *u
+	return &tmp, nil
+}
+
+func (r *fakeSignPolicyRepo) GetSignUsagesByDay(ctx context.Context, token string, day string) ([]*biz.SignUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]*biz.SignUsage, 0)
+	for _, u := range r.usages {
+		if token == u.Token && day == u.Day {
+			tmp := *u
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeSignPolicyRepo) SaveSignUsage(ctx context.Context, u *biz.SignUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if 0 == u.ID {
+		r.nextId++
+		u.ID = r.nextId
+	}
+	tmp := *u
+	r.usages[fmt.Sprintf("%s-%d-%s", u.Wallet, u.Nonce, u.Token)] = &tmp
+	return nil
+}
+
+func (r *fakeSignPolicyRepo) IsUserAddress(ctx context.Context, address string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.users[strings.ToLower(address)], nil
+}
+
+// testSignerDaemon 与cmd/signer相同的中间件，跑在bufconn上
+type testSignerDaemon struct {
+	lis    *bufconn.Listener
+	signer *data.MemorySigner
+	sweep  *data.HDSweepSigner
+}
+
+func newTestSignerDaemon(t *testing.T, repo biz.SignPolicyRepo, o *biz.SignPolicyOption) *testSignerDaemon {
+	privateKey, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if nil != err {
+		t.Fatal(err)
+	}
+	master, err := bip32.NewMasterKey(make([]byte, 32))
+	if nil != err {
+		t.Fatal(err)
+	}
+	sweep, err := data.NewHDSweepSigner(master.B58Serialize())
+	if nil != err {
+		t.Fatal(err)
+	}
+	d := &testSignerDaemon{lis: bufconn.Listen(1024 * 1024), signer: data.NewMemorySigner(privateKey), sweep: sweep}
+
+	if nil == o {
+		o = &biz.SignPolicyOption{
+			ChainId:           testChainId.Int64(),
+			DailyLimits:       map[common.Address]*big.Int{testToken: big.NewInt(100)},
+			AllowDestinations: []common.Address{testCold},
+			GasFundingMax:     big.NewInt(10),
+			GasFundingDaily:   big.NewInt(15),
+		}
+	}
+	policy := biz.NewSignPolicy(o, d.signer.Address(), sweep, repo)
+
+	gs := kgrpc.NewServer(kgrpc.Middleware(
+		recovery.Recovery(),
+		auth.SharedTokenServer(testSignerToken),
+	))
+	v1.RegisterSignerServer(gs, NewSignerService(d.signer, sweep, policy, log.DefaultLogger))
+	go func() {
+		_ = gs.Serve(d.lis)
+	}()
+	t.Cleanup(func() {
+		_ = gs.Stop(context.Background())
+	})
+
+	return d
+}
+
+func (d *testSignerDaemon) client(token string) (*data.RemoteSigner, error) {
+	return data.NewRemoteSigner("bufnet", token, time.Second, kgrpc.WithOptions(
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return d.lis.DialContext(ctx)
+		}),
+	))
+}
+
+func (d *testSignerDaemon) mustClient(t *testing.T) *data.RemoteSigner {
+	s, err := d.client(testSignerToken)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = s.Close()
+	})
+	return s
+}
+
+func transferTx(nonce uint64, to common.Address, amount int64, gasPrice int64) *types.Transaction {
+	data := append([]byte{}, crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+	return types.NewTransaction(nonce, testToken, big.NewInt(0), 100000, big.NewInt(gasPrice), data)
+}
+
+func assertSigned(t *testing.T, s *data.RemoteSigner, signedTx *types.Transaction, err error) {
+	t.Helper()
+	if nil != err {
+		t.Fatal(err)
+	}
+	from, err := types.Sender(types.NewEIP155Signer(testChainId), signedTx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if from != s.Address() {
+		t.Fatalf("sender %s, want %s", from.Hex(), s.Address().Hex())
+	}
+}
+
+func assertRejected(t *testing.T, err error) {
+	t.Helper()
+	if 403 != errors.Code(err) {
+		t.Fatalf("want policy rejection, got %v", err)
+	}
+}
+
+func TestSignerSharedToken(t *testing.T) {
+	d := newTestSignerDaemon(t, newFakeSignPolicyRepo(testUser), nil)
+
+	if _, err := d.client("wrong"); 401 != errors.Code(err) {
+		t.Fatalf("wrong token: want 401, got %v", err)
+	}
+	if _, err := d.client(""); nil == err {
+		t.Fatal("empty token: want error")
+	}
+
+	s := d.mustClient(t)
+	if s.Address() != d.signer.Address() {
+		t.Fatalf("address %s, want %s", s.Address().Hex(), d.signer.Address().Hex())
+	}
+}
+
+func TestSignerDailyLimitPersisted(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSignPolicyRepo(testUser)
+	s := newTestSignerDaemon(t, repo, nil).mustClient(t)
+
+	signedTx, err := s.SignTx(ctx, transferTx(1, testUser, 60, 5), testChainId)
+	assertSigned(t, s, signedTx, err)
+
+	// 加速重签同一nonce不重复计入
+	signedTx, err = s.SignTx(ctx, transferTx(1, testUser, 60, 6), testChainId)
+	assertSigned(t, s, signedTx, err)
+	signedTx, err = s.SignTx(ctx, transferTx(2, testUser, 40, 5), testChainId)
+	assertSigned(t, s, signedTx, err)
+
+	// 重启后额度仍然在
+	s = newTestSignerDaemon(t, repo, nil).mustClient(t)
+	_, err = s.SignTx(ctx, transferTx(3, testUser, 1, 5), testChainId)
+	assertRejected(t, err)
+
+	// 取消交易不占额度
+	cancelTx := types.NewTransaction(3, s.Address(), big.NewInt(0), 21000, big.NewInt(6), nil)
+	signedTx, err = s.SignTx(ctx, cancelTx, testChainId)
+	assertSigned(t, s, signedTx, err)
+}
+
+func TestSignerDestination(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSignerDaemon(t, newFakeSignPolicyRepo(testUser), nil).mustClient(t)
+
+	_, err := s.SignTx(ctx, transferTx(1, testOther, 1, 5), testChainId)
+	assertRejected(t, err)
+	_, err = s.SignTx(ctx, transferTx(1, common.Address{}, 1, 5), testChainId)
+	assertRejected(t, err)
+
+	signedTx, err := s.SignTx(ctx, transferTx(1, testCold, 1, 5), testChainId)
+	assertSigned(t, s, signedTx, err)
+	signedTx, err = s.SignTx(ctx, transferTx(2, testUser, 1, 5), testChainId)
+	assertSigned(t, s, signedTx, err)
+}
+
+func TestSignerMissingLimit(t *testing.T) {
+	s := newTestSignerDaemon(t, newFakeSignPolicyRepo(testUser), &biz.SignPolicyOption{
+		ChainId:     testChainId.Int64(),
+		DailyLimits: map[common.Address]*big.Int{testToken: nil},
+	}).mustClient(t)
+
+	_, err := s.SignTx(context.Background(), transferTx(1, testUser, 1, 5), testChainId)
+	assertRejected(t, err)
+}
+
+func TestSignerGasFunding(t *testing.T) {
+	ctx := context.Background()
+	d := newTestSignerDaemon(t, newFakeSignPolicyRepo(testUser), nil)
+	s := d.mustClient(t)
+
+	depositAddress, err := d.sweep.DeriveAddress(7)
+	if nil != err {
+		t.Fatal(err)
+	}
+	gasTx := func(nonce uint64, to common.Address, value int64) *types.Transaction {
+		return types.NewTransaction(nonce, to, big.NewInt(value), 21000, big.NewInt(5), nil)
+	}
+
+	// 不带派生索引或收款地址不是该索引的充值地址
+	_, err = s.SignTx(ctx, gasTx(1, common.HexToAddress(depositAddress), 5), testChainId)
+	assertRejected(t, err)
+	_, err = s.SignGasTx(ctx, 7, gasTx(1, testUser, 5), testChainId)
+	assertRejected(t, err)
+	_, err = s.SignGasTx(ctx, 8, gasTx(1, common.HexToAddress(depositAddress), 5), testChainId)
+	assertRejected(t, err)
+
+	// 单笔上限
+	_, err = s.SignGasTx(ctx, 7, gasTx(1, common.HexToAddress(depositAddress), 11), testChainId)
+	assertRejected(t, err)
+
+	signedTx, err := s.SignGasTx(ctx, 7, gasTx(1, common.HexToAddress(depositAddress), 10), testChainId)
+	assertSigned(t, s, signedTx, err)
+
+	// 每日上限
+	_, err = s.SignGasTx(ctx, 7, gasTx(2, common.HexToAddress(depositAddress), 6), testChainId)
+	assertRejected(t, err)
+	signedTx, err = s.SignGasTx(ctx, 7, gasTx(2, common.HexToAddress(depositAddress), 5), testChainId)
+	assertSigned(t, s, signedTx, err)
+}